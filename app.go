@@ -2,7 +2,10 @@ package stream_chat
 
 import (
 	"context"
+	"errors"
 	"net/http"
+	"net/url"
+	"path"
 	"time"
 )
 
@@ -28,6 +31,9 @@ type AppSettings struct {
 	SqsURL                   *string                `json:"sqs_url,omitempty"`
 	SqsKey                   *string                `json:"sqs_key,omitempty"`
 	SqsSecret                *string                `json:"sqs_secret,omitempty"`
+	SnsTopicArn              *string                `json:"sns_topic_arn,omitempty"`
+	SnsKey                   *string                `json:"sns_key,omitempty"`
+	SnsSecret                *string                `json:"sns_secret,omitempty"`
 	BeforeMessageSendHookURL *string                `json:"before_message_send_hook_url,omitempty"`
 	CustomActionHandlerURL   *string                `json:"custom_action_handler_url,omitempty"`
 
@@ -48,6 +54,11 @@ type AppSettings struct {
 	UserSearchDisallowedRoles []string `json:"user_search_disallowed_roles,omitempty"`
 	EnforceUniqueUsernames    *string  `json:"enforce_unique_usernames,omitempty"`
 	ChannelHideMembersOnly    *bool    `json:"channel_hide_members_only,omitempty"`
+
+	// MessageHistoryEnabled retains the previous versions of edited messages,
+	// which are returned by GetMessageHistory. Every edit is stored as a full copy
+	// of the message and counts towards the app's storage.
+	MessageHistoryEnabled *bool `json:"message_history_enabled,omitempty"`
 }
 
 func (a *AppSettings) SetDisableAuth(b bool) *AppSettings {
@@ -80,6 +91,11 @@ func (a *AppSettings) SetMultiTenant(b bool) *AppSettings {
 	return a
 }
 
+func (a *AppSettings) SetMessageHistoryEnabled(b bool) *AppSettings {
+	a.MessageHistoryEnabled = &b
+	return a
+}
+
 func (a *AppSettings) SetGrants(g map[string][]string) *AppSettings {
 	a.Grants = g
 	return a
@@ -109,12 +125,13 @@ type APNConfig struct {
 }
 
 type PushNotificationFields struct {
-	Version        string         `json:"version"`
-	OfflineOnly    bool           `json:"offline_only"`
-	APNConfig      APNConfig      `json:"apn"`
-	FirebaseConfig FirebaseConfig `json:"firebase"`
-	HuaweiConfig   HuaweiConfig   `json:"huawei"`
-	XiaomiConfig   XiaomiConfig   `json:"xiaomi"`
+	Version        string          `json:"version"`
+	OfflineOnly    bool            `json:"offline_only"`
+	APNConfig      APNConfig       `json:"apn"`
+	FirebaseConfig FirebaseConfig  `json:"firebase"`
+	HuaweiConfig   HuaweiConfig    `json:"huawei"`
+	XiaomiConfig   XiaomiConfig    `json:"xiaomi"`
+	Providers      []*PushProvider `json:"providers,omitempty"`
 }
 
 type FirebaseConfigRequest struct {
@@ -129,6 +146,7 @@ type FirebaseConfig struct {
 	Enabled              bool   `json:"enabled"`
 	NotificationTemplate string `json:"notification_template"`
 	DataTemplate         string `json:"data_template"`
+	APNTemplate          string `json:"apn_template"`
 }
 
 type XiaomiConfigRequest struct {
@@ -180,14 +198,50 @@ func (c *Client) GetAppSettings(ctx context.Context) (*AppResponse, error) {
 
 // UpdateAppSettings makes request to update app settings
 // Example of usage:
-//  settings := NewAppSettings().SetDisableAuth(true)
-//  err := client.UpdateAppSettings(settings)
+//
+//	settings := NewAppSettings().SetDisableAuth(true)
+//	err := client.UpdateAppSettings(settings)
 func (c *Client) UpdateAppSettings(ctx context.Context, settings *AppSettings) (*Response, error) {
 	var resp Response
 	err := c.makeRequest(ctx, http.MethodPatch, "app", nil, settings, &resp)
 	return &resp, err
 }
 
+// SetWebhookURL updates only the webhook URL of the app, leaving other settings untouched.
+func (c *Client) SetWebhookURL(ctx context.Context, webhookURL string) (*Response, error) {
+	data := map[string]string{"webhook_url": webhookURL}
+
+	var resp Response
+	err := c.makeRequest(ctx, http.MethodPatch, "app", nil, data, &resp)
+	return &resp, err
+}
+
+// SetSQSConfig updates only the SQS queue URL and credentials of the app, leaving other settings untouched.
+func (c *Client) SetSQSConfig(ctx context.Context, sqsURL, key, secret string) (*Response, error) {
+	data := map[string]string{
+		"sqs_url":    sqsURL,
+		"sqs_key":    key,
+		"sqs_secret": secret,
+	}
+
+	var resp Response
+	err := c.makeRequest(ctx, http.MethodPatch, "app", nil, data, &resp)
+	return &resp, err
+}
+
+// SetSNSConfig updates only the SNS topic ARN and credentials of the app, leaving other settings untouched.
+func (c *Client) SetSNSConfig(ctx context.Context, topicArn, key, secret string) (*Response, error) {
+	data := map[string]string{
+		"sns_topic_arn": topicArn,
+		"sns_key":       key,
+		"sns_secret":    secret,
+	}
+
+	var resp Response
+	err := c.makeRequest(ctx, http.MethodPatch, "app", nil, data, &resp)
+	return &resp, err
+}
+
 type CheckSQSRequest struct {
 	SqsURL    string `json:"sqs_url"`
 	SqsKey    string `json:"sqs_key"`
@@ -208,6 +262,26 @@ func (c *Client) CheckSqs(ctx context.Context, req *CheckSQSRequest) (*CheckSQSR
 	return &resp, err
 }
 
+type CheckSNSRequest struct {
+	SnsTopicArn string `json:"sns_topic_arn"`
+	SnsKey      string `json:"sns_key"`
+	SnsSecret   string `json:"sns_secret"`
+}
+
+type CheckSNSResponse struct {
+	Status string                 `json:"status"`
+	Error  string                 `json:"error"`
+	Data   map[string]interface{} `json:"data"`
+	Response
+}
+
+// CheckSns checks whether the AWS credentials are valid for the SNS topic access.
+func (c *Client) CheckSns(ctx context.Context, req *CheckSNSRequest) (*CheckSNSResponse, error) {
+	var resp CheckSNSResponse
+	err := c.makeRequest(ctx, http.MethodPost, "check_sns", nil, req, &resp)
+	return &resp, err
+}
+
 type CheckPushRequest struct {
 	MessageID            string `json:"message_id,omitempty"`
 	ApnTemplate          string `json:"apn_template,omitempty"`
@@ -236,8 +310,17 @@ type CheckPushResponse struct {
 	Response
 }
 
-// CheckPush initiates a push test.
+// CheckPush initiates a push test: it renders the push templates for the given user and
+// message, if any, without sending anything, and reports per device and general errors.
+// Set SkipDevices to render templates without checking the user's devices.
 func (c *Client) CheckPush(ctx context.Context, req *CheckPushRequest) (*CheckPushResponse, error) {
+	switch {
+	case req == nil:
+		return nil, errors.New("request is nil")
+	case req.UserID == "" && req.User == nil:
+		return nil, errors.New("user ID or user must be set")
+	}
+
 	var resp CheckPushResponse
 	err := c.makeRequest(ctx, http.MethodPost, "check_push", nil, req, &resp)
 	return &resp, err
@@ -281,7 +364,17 @@ type PushProvider struct {
 }
 
 // UpsertPushProvider inserts or updates a push provider.
+// Providers are identified by their type and name.
 func (c *Client) UpsertPushProvider(ctx context.Context, provider *PushProvider) (*Response, error) {
+	switch {
+	case provider == nil:
+		return nil, errors.New("push provider is nil")
+	case provider.Type == "":
+		return nil, errors.New("push provider type must be not empty")
+	case provider.Name == "":
+		return nil, errors.New("push provider name must be not empty")
+	}
+
 	body := map[string]PushProvider{"push_provider": *provider}
 	var resp Response
 	err := c.makeRequest(ctx, http.MethodPost, "push_providers", nil, body, &resp)
@@ -290,8 +383,17 @@ func (c *Client) UpsertPushProvider(ctx context.Context, provider *PushProvider)
 
 // DeletePushProvider deletes a push provider.
 func (c *Client) DeletePushProvider(ctx context.Context, providerType, name string) (*Response, error) {
+	switch {
+	case providerType == "":
+		return nil, errors.New("push provider type must be not empty")
+	case name == "":
+		return nil, errors.New("push provider name must be not empty")
+	}
+
+	p := path.Join("push_providers", url.PathEscape(providerType), url.PathEscape(name))
+
 	var resp Response
-	err := c.makeRequest(ctx, http.MethodDelete, "push_providers/"+providerType+"/"+name, nil, nil, &resp)
+	err := c.makeRequest(ctx, http.MethodDelete, p, nil, nil, &resp)
 	return &resp, err
 }
 
@@ -2,6 +2,7 @@ package stream_chat
 
 import (
 	"context"
+	"encoding/json"
 	"log"
 	"testing"
 
@@ -15,6 +16,46 @@ func TestClient_GetApp(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestAppResponse_PushNotificationTemplates(t *testing.T) {
+	apnTemplate := `{"aps":{"alert":{"title":"{{ sender.name }}","body":"{{ truncate message.text 2000 }}"}}}`
+	notificationTemplate := `{"title":"{{ sender.name }}","body":"{{ truncate message.text 2000 }}"}`
+	dataTemplate := `{"sender":"{{ sender.id }}","message_id":"{{ message.id }}"}`
+
+	payload, err := json.Marshal(map[string]interface{}{
+		"app": map[string]interface{}{
+			"push_notifications": map[string]interface{}{
+				"version":      "v2",
+				"offline_only": true,
+				"apn": map[string]interface{}{
+					"enabled":               true,
+					"notification_template": apnTemplate,
+				},
+				"firebase": map[string]interface{}{
+					"enabled":               true,
+					"notification_template": notificationTemplate,
+					"data_template":         dataTemplate,
+					"apn_template":          apnTemplate,
+				},
+				"providers": []map[string]interface{}{
+					{"type": "firebase", "name": "staging", "firebase_notification_template": notificationTemplate},
+				},
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	var resp AppResponse
+	require.NoError(t, json.Unmarshal(payload, &resp))
+
+	push := resp.App.PushNotifications
+	require.Equal(t, apnTemplate, push.APNConfig.NotificationTemplate)
+	require.Equal(t, notificationTemplate, push.FirebaseConfig.NotificationTemplate)
+	require.Equal(t, dataTemplate, push.FirebaseConfig.DataTemplate)
+	require.Equal(t, apnTemplate, push.FirebaseConfig.APNTemplate)
+	require.Len(t, push.Providers, 1)
+	require.Equal(t, notificationTemplate, *push.Providers[0].FirebaseNotificationTemplate)
+}
+
 func TestClient_UpdateAppSettings(t *testing.T) {
 	c := initClient(t)
 	ctx := context.Background()
@@ -27,6 +68,19 @@ func TestClient_UpdateAppSettings(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestClient_UpdateAppSettings_MessageHistory(t *testing.T) {
+	c := initClient(t)
+	ctx := context.Background()
+
+	_, err := c.UpdateAppSettings(ctx, NewAppSettings().SetMessageHistoryEnabled(true))
+	require.NoError(t, err)
+
+	resp, err := c.GetAppSettings(ctx)
+	require.NoError(t, err)
+	require.NotNil(t, resp.App.MessageHistoryEnabled)
+	require.True(t, *resp.App.MessageHistoryEnabled)
+}
+
 func TestClient_UpdateAppSettingsClearing(t *testing.T) {
 	c := initClient(t)
 	ctx := context.Background()
@@ -53,6 +107,71 @@ func TestClient_UpdateAppSettingsClearing(t *testing.T) {
 	require.Equal(t, *settings.SqsURL, *s.App.SqsURL)
 }
 
+func TestClient_GetAppWebhookConfig(t *testing.T) {
+	c := initClient(t)
+	ctx := context.Background()
+
+	webhookURL := "https://example.com/webhook"
+	beforeMessageSendHookURL := "https://example.com/before_message_send"
+	customActionHandlerURL := "https://example.com/custom_action"
+	sqsURL := "https://sqs.us-east-1.amazonaws.com/123456789012/stream"
+
+	settings := NewAppSettings().SetWebhookURL(webhookURL)
+	settings.WebhookEvents = []string{"message.new", "message.updated"}
+	settings.BeforeMessageSendHookURL = &beforeMessageSendHookURL
+	settings.CustomActionHandlerURL = &customActionHandlerURL
+	settings.SqsURL = &sqsURL
+
+	_, err := c.UpdateAppSettings(ctx, settings)
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		empty := ""
+		_, _ = c.UpdateAppSettings(ctx, &AppSettings{
+			WebhookURL:               &empty,
+			BeforeMessageSendHookURL: &empty,
+			CustomActionHandlerURL:   &empty,
+			SqsURL:                   &empty,
+		})
+	})
+
+	resp, err := c.GetAppSettings(ctx)
+	require.NoError(t, err)
+	require.Equal(t, webhookURL, *resp.App.WebhookURL)
+	require.ElementsMatch(t, settings.WebhookEvents, resp.App.WebhookEvents)
+	require.Equal(t, beforeMessageSendHookURL, *resp.App.BeforeMessageSendHookURL)
+	require.Equal(t, customActionHandlerURL, *resp.App.CustomActionHandlerURL)
+	require.Equal(t, sqsURL, *resp.App.SqsURL)
+}
+
+func TestClient_SetWebhookURL(t *testing.T) {
+	c := initClient(t)
+	ctx := context.Background()
+
+	sqsURL := "https://sqs.us-east-1.amazonaws.com/123456789012/stream"
+	snsTopicArn := "arn:aws:sns:us-east-1:123456789012:stream"
+
+	_, err := c.SetSQSConfig(ctx, sqsURL, "key", "secret")
+	require.NoError(t, err)
+	_, err = c.SetSNSConfig(ctx, snsTopicArn, "key", "secret")
+	require.NoError(t, err)
+	_, err = c.SetWebhookURL(ctx, "https://example.com/webhook")
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		_, _ = c.SetWebhookURL(ctx, "")
+		_, _ = c.SetSQSConfig(ctx, "", "", "")
+		_, _ = c.SetSNSConfig(ctx, "", "", "")
+	})
+
+	resp, err := c.GetAppSettings(ctx)
+	require.NoError(t, err)
+	require.Equal(t, "https://example.com/webhook", *resp.App.WebhookURL)
+	// setting the webhook URL must not clobber the queue settings
+	require.Equal(t, sqsURL, *resp.App.SqsURL)
+	require.Equal(t, snsTopicArn, *resp.App.SnsTopicArn)
+}
+
 func TestClient_CheckSqs(t *testing.T) {
 	c := initClient(t)
 	ctx := context.Background()
@@ -66,6 +185,19 @@ func TestClient_CheckSqs(t *testing.T) {
 	require.NotNil(t, resp.Data)
 }
 
+func TestClient_CheckSns(t *testing.T) {
+	c := initClient(t)
+	ctx := context.Background()
+
+	req := &CheckSNSRequest{SnsTopicArn: "arn:aws:sns:us-east-1:123456789012:sns-topic", SnsKey: "key", SnsSecret: "secret"}
+	resp, err := c.CheckSns(ctx, req)
+
+	require.NoError(t, err)
+	require.NotEmpty(t, resp.Error)
+	require.Equal(t, "error", resp.Status)
+	require.NotNil(t, resp.Data)
+}
+
 func TestClient_CheckPush(t *testing.T) {
 	c := initClient(t)
 	ch := initChannel(t, c)
@@ -79,6 +211,46 @@ func TestClient_CheckPush(t *testing.T) {
 
 	require.NoError(t, err)
 	require.Equal(t, msgResp.Message.ID, resp.RenderedMessage["message_id"])
+	require.NotEmpty(t, resp.RenderedApnTemplate)
+	require.NotEmpty(t, resp.RenderedFirebaseTemplate)
+
+	_, err = c.CheckPush(ctx, nil)
+	require.Error(t, err)
+}
+
+func TestClient_PushProviders(t *testing.T) {
+	c := initClient(t)
+	ctx := context.Background()
+
+	provider := &PushProvider{
+		Type:              PushProviderXiaomi,
+		Name:              randomString(10),
+		XiaomiPackageName: "io.getstream.chat",
+		XiaomiAppSecret:   randomString(10),
+	}
+	_, err := c.UpsertPushProvider(ctx, provider)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_, _ = c.DeletePushProvider(ctx, provider.Type, provider.Name)
+	})
+
+	_, err = c.UpsertPushProvider(ctx, &PushProvider{Type: PushProviderXiaomi})
+	require.Error(t, err)
+
+	resp, err := c.ListPushProviders(ctx)
+	require.NoError(t, err)
+
+	var found bool
+	for _, p := range resp.PushProviders {
+		if p.Type == provider.Type && p.Name == provider.Name {
+			found = true
+			break
+		}
+	}
+	require.True(t, found, "push provider should be listed")
+
+	_, err = c.DeletePushProvider(ctx, provider.Type, provider.Name)
+	require.NoError(t, err)
 }
 
 // See https://getstream.io/chat/docs/app_settings_auth/ for
@@ -20,6 +20,11 @@ const (
 	TaskStatusFailed    TaskStatus = "failed"
 )
 
+type TaskError struct {
+	Type        string `json:"type"`
+	Description string `json:"description"`
+}
+
 type TaskResponse struct {
 	TaskID    string     `json:"task_id"`
 	Status    TaskStatus `json:"status"`
@@ -27,6 +32,7 @@ type TaskResponse struct {
 	UpdatedAt time.Time  `json:"updated_at"`
 
 	Result map[string]interface{} `json:"result,omitempty"`
+	Error  *TaskError             `json:"error,omitempty"`
 	Response
 }
 
@@ -43,6 +49,40 @@ func (c *Client) GetTask(ctx context.Context, id string) (*TaskResponse, error)
 	return &task, err
 }
 
+// WaitForTask polls the task with given ID every pollInterval until it is either completed or failed,
+// or until the context is done. A failed task is returned along with an error describing the failure.
+func (c *Client) WaitForTask(ctx context.Context, id string, pollInterval time.Duration) (*TaskResponse, error) {
+	if pollInterval <= 0 {
+		return nil, errors.New("poll interval must be positive")
+	}
+
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+
+	for {
+		task, err := c.GetTask(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+
+		switch task.Status {
+		case TaskStatusCompleted:
+			return task, nil
+		case TaskStatusFailed:
+			if task.Error != nil {
+				return task, fmt.Errorf("task %s failed: %s", id, task.Error.Description)
+			}
+			return task, fmt.Errorf("task %s failed", id)
+		}
+
+		select {
+		case <-ctx.Done():
+			return task, ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
 type AsyncTaskResponse struct {
 	TaskID string `json:"task_id"`
 	Response
@@ -50,7 +90,8 @@ type AsyncTaskResponse struct {
 
 // DeleteChannels deletes channels asynchronously.
 // Channels and messages will be hard deleted if hardDelete is true.
-// It returns an AsyncTaskResponse object which contains the task ID, the status of the task can be check with client.GetTask method.
+// It returns an AsyncTaskResponse object which contains the task ID, the status of the task can be check with client.GetTask method
+// or awaited with client.WaitForTask.
 func (c *Client) DeleteChannels(ctx context.Context, cids []string, hardDelete bool) (*AsyncTaskResponse, error) {
 	if len(cids) == 0 {
 		return nil, fmt.Errorf("cids parameter should not be empty")
@@ -74,6 +115,9 @@ type DeleteType string
 const (
 	HardDelete DeleteType = "hard"
 	SoftDelete DeleteType = "soft"
+	// PruneDelete soft deletes the user and removes all of their data except the user ID.
+	// It is only supported for the User field of DeleteUserOptions.
+	PruneDelete DeleteType = "pruning"
 )
 
 type DeleteUserOptions struct {
@@ -84,7 +128,7 @@ type DeleteUserOptions struct {
 }
 
 // DeleteUsers deletes users asynchronously.
-// User will be deleted either "hard" or "soft"
+// User will be deleted either "hard", "soft" or "pruning"
 // Conversations (1to1 channels) will be deleted if either "hard" or "soft"
 // Messages will be deleted if either "hard" or "soft"
 // NewChannelOwnerID any channels owned by the hard-deleted user will be transferred to this user ID
@@ -107,6 +151,48 @@ func (c *Client) DeleteUsers(ctx context.Context, userIDs []string, options Dele
 	return &resp, err
 }
 
+type ReactivateUsersOptions struct {
+	RestoreMessages bool   `json:"restore_messages,omitempty"`
+	CreatedByID     string `json:"created_by_id,omitempty"`
+}
+
+// ReactivateUsers reactivates deactivated users asynchronously.
+// Messages of the users are restored if RestoreMessages is true.
+// It returns an AsyncTaskResponse object which contains the task ID, the status of the task can be check with client.GetTask method
+// or awaited with client.WaitForTask.
+func (c *Client) ReactivateUsers(ctx context.Context, userIDs []string, options ReactivateUsersOptions) (*AsyncTaskResponse, error) {
+	if len(userIDs) == 0 {
+		return nil, fmt.Errorf("userIDs parameter should not be empty")
+	}
+
+	data := struct {
+		ReactivateUsersOptions
+		UserIDs []string `json:"user_ids"`
+	}{
+		ReactivateUsersOptions: options,
+		UserIDs:                userIDs,
+	}
+
+	var resp AsyncTaskResponse
+	err := c.makeRequest(ctx, http.MethodPost, "users/reactivate", nil, data, &resp)
+	return &resp, err
+}
+
+// ExportUsers requests an asynchronous export of the data of the provided users, including their messages.
+// It returns an AsyncTaskResponse object which contains the task ID, the status of the task can be check with client.GetTask method.
+// Once completed, the task result contains the download URL of the export.
+func (c *Client) ExportUsers(ctx context.Context, userIDs []string) (*AsyncTaskResponse, error) {
+	if len(userIDs) == 0 {
+		return nil, errors.New("number of user IDs must be at least one")
+	}
+
+	req := map[string][]string{"user_ids": userIDs}
+
+	var resp AsyncTaskResponse
+	err := c.makeRequest(ctx, http.MethodPost, "export/users", nil, req, &resp)
+	return &resp, err
+}
+
 type ExportableChannel struct {
 	Type          string     `json:"type"`
 	ID            string     `json:"id"`
@@ -176,3 +262,35 @@ func (c *Client) GetExportChannelsTask(ctx context.Context, taskID string) (*Tas
 	err := c.makeRequest(ctx, http.MethodGet, p, nil, nil, &task)
 	return &task, err
 }
+
+type ExportChannelsResult struct {
+	// URL is the signed URL of the export archive, it expires after a while.
+	URL          string `json:"url"`
+	Path         string `json:"path"`
+	S3BucketName string `json:"s3_bucket_name"`
+}
+
+type ExportChannelsResponse struct {
+	TaskID    string     `json:"task_id"`
+	Status    TaskStatus `json:"status"`
+	CreatedAt time.Time  `json:"created_at"`
+	UpdatedAt time.Time  `json:"updated_at"`
+
+	Result *ExportChannelsResult `json:"result,omitempty"`
+	Error  *TaskError            `json:"error,omitempty"`
+	Response
+}
+
+// GetExportChannelsStatus returns current state of the export task.
+// Once the task is completed, the result contains the download URL of the export archive.
+func (c *Client) GetExportChannelsStatus(ctx context.Context, taskID string) (*ExportChannelsResponse, error) {
+	if taskID == "" {
+		return nil, errors.New("task ID must be not empty")
+	}
+
+	p := path.Join("export_channels", url.PathEscape(taskID))
+
+	var resp ExportChannelsResponse
+	err := c.makeRequest(ctx, http.MethodGet, p, nil, nil, &resp)
+	return &resp, err
+}
@@ -40,6 +40,26 @@ func TestClient_DeleteChannels(t *testing.T) {
 	}
 }
 
+func TestClient_WaitForTask(t *testing.T) {
+	c := initClient(t)
+	ch := initChannel(t, c)
+	ctx := context.Background()
+
+	resp, err := c.DeleteChannels(ctx, []string{ch.CID}, true)
+	require.NoError(t, err)
+
+	_, err = c.WaitForTask(ctx, resp.TaskID, 0)
+	require.Error(t, err)
+
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	task, err := c.WaitForTask(ctx, resp.TaskID, 500*time.Millisecond)
+	require.NoError(t, err)
+	require.Equal(t, TaskStatusCompleted, task.Status)
+	require.Equal(t, map[string]interface{}{"status": "ok"}, task.Result[ch.CID])
+}
+
 func TestClient_DeleteUsers(t *testing.T) {
 	c := initClient(t)
 	ch := initChannel(t, c)
@@ -81,6 +101,54 @@ func TestClient_DeleteUsers(t *testing.T) {
 	require.True(t, false, "task did not succeed")
 }
 
+func TestClient_DeleteUsers_Pruning(t *testing.T) {
+	c := initClient(t)
+	ctx := context.Background()
+	user := randomUser(t, c)
+
+	resp, err := c.DeleteUsers(ctx, []string{user.ID}, DeleteUserOptions{
+		User:     PruneDelete,
+		Messages: HardDelete,
+	})
+	require.NoError(t, err)
+	require.NotEmpty(t, resp.TaskID)
+
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	task, err := c.WaitForTask(ctx, resp.TaskID, time.Second)
+	require.NoError(t, err)
+	require.Equal(t, map[string]interface{}{"status": "ok"}, task.Result[user.ID])
+}
+
+func TestClient_ReactivateUsers(t *testing.T) {
+	c := initClient(t)
+	ctx := context.Background()
+	creator := randomUser(t, c)
+
+	users := []*User{randomUser(t, c), randomUser(t, c)}
+	userIDs := make([]string, 0, len(users))
+	for _, u := range users {
+		_, err := c.DeactivateUser(ctx, u.ID)
+		require.NoError(t, err)
+		userIDs = append(userIDs, u.ID)
+	}
+
+	resp, err := c.ReactivateUsers(ctx, userIDs, ReactivateUsersOptions{
+		RestoreMessages: true,
+		CreatedByID:     creator.ID,
+	})
+	require.NoError(t, err)
+	require.NotEmpty(t, resp.TaskID)
+
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	task, err := c.WaitForTask(ctx, resp.TaskID, time.Second)
+	require.NoError(t, err)
+	require.Equal(t, TaskStatusCompleted, task.Status)
+}
+
 func TestClient_ExportChannels(t *testing.T) {
 	c := initClient(t)
 	ch1 := initChannel(t, c)
@@ -123,4 +191,54 @@ func TestClient_ExportChannels(t *testing.T) {
 			time.Sleep(time.Second)
 		}
 	})
+
+	t.Run("Export channels and get the download URL", func(t *testing.T) {
+		expChannels := []*ExportableChannel{
+			{Type: ch1.Type, ID: ch1.ID},
+		}
+		includeTruncated := true
+
+		resp1, err := c.ExportChannels(ctx, expChannels, &ExportChannelOptions{IncludeTruncatedMessages: &includeTruncated})
+		require.NoError(t, err)
+		require.NotEmpty(t, resp1.TaskID)
+
+		for i := 0; i < 10; i++ {
+			status, err := c.GetExportChannelsStatus(ctx, resp1.TaskID)
+			require.NoError(t, err)
+			require.Equal(t, resp1.TaskID, status.TaskID)
+
+			if status.Status == TaskStatusCompleted {
+				require.NotNil(t, status.Result)
+				require.NotEmpty(t, status.Result.URL)
+				return
+			}
+
+			time.Sleep(time.Second)
+		}
+		require.Fail(t, "export task did not complete")
+	})
+}
+
+func TestClient_ExportUsers(t *testing.T) {
+	c := initClient(t)
+	ch := initChannel(t, c)
+	ctx := context.Background()
+	user := randomUser(t, c)
+
+	_, err := ch.SendMessage(ctx, &Message{Text: "test message"}, user.ID, MessageSkipPush)
+	require.NoError(t, err, "send message")
+
+	_, err = c.ExportUsers(ctx, nil)
+	require.Error(t, err)
+
+	resp, err := c.ExportUsers(ctx, []string{user.ID})
+	require.NoError(t, err)
+	require.NotEmpty(t, resp.TaskID)
+
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	task, err := c.WaitForTask(ctx, resp.TaskID, time.Second)
+	require.NoError(t, err)
+	require.NotEmpty(t, task.Result["url"])
 }
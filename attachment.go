@@ -0,0 +1,171 @@
+package stream_chat
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+const (
+	AttachmentTypeImage = "image"
+	AttachmentTypeFile  = "file"
+	AttachmentTypeGiphy = "giphy"
+)
+
+// AttachmentAction is a button of an interactive attachment.
+// When clicked, the Name and Value are sent to the custom action handler.
+type AttachmentAction struct {
+	Name  string `json:"name"`
+	Text  string `json:"text"`
+	Type  string `json:"type"` // button
+	Style string `json:"style,omitempty"`
+	Value string `json:"value,omitempty"`
+}
+
+// NewImageAttachment returns an image attachment for the image at imageURL.
+// fallback is shown by clients which can't display the image.
+func NewImageAttachment(imageURL, fallback string) (*Attachment, error) {
+	if imageURL == "" {
+		return nil, errors.New("image URL must be not empty")
+	}
+
+	return &Attachment{
+		Type:     AttachmentTypeImage,
+		ImageURL: imageURL,
+		Fallback: fallback,
+	}, nil
+}
+
+// NewFileAttachment returns a file attachment for the file at assetURL.
+func NewFileAttachment(assetURL, title, mimeType string, fileSize int64) (*Attachment, error) {
+	switch {
+	case assetURL == "":
+		return nil, errors.New("asset URL must be not empty")
+	case fileSize < 0:
+		return nil, errors.New("file size must not be negative")
+	}
+
+	return &Attachment{
+		Type:     AttachmentTypeFile,
+		AssetURL: assetURL,
+		Title:    title,
+		MimeType: mimeType,
+		FileSize: fileSize,
+	}, nil
+}
+
+// NewGiphyAttachment returns a giphy attachment for the gif at imageURL.
+// titleLink is the link to the gif page and thumbURL its preview, both are optional.
+func NewGiphyAttachment(title, titleLink, imageURL, thumbURL string) (*Attachment, error) {
+	if imageURL == "" {
+		return nil, errors.New("image URL must be not empty")
+	}
+
+	return &Attachment{
+		Type:      AttachmentTypeGiphy,
+		Title:     title,
+		TitleLink: titleLink,
+		ImageURL:  imageURL,
+		ThumbURL:  thumbURL,
+	}, nil
+}
+
+// AddAction adds an action to the attachment, making it interactive.
+func (a *Attachment) AddAction(action *AttachmentAction) error {
+	switch {
+	case action == nil:
+		return errors.New("action is nil")
+	case action.Name == "":
+		return errors.New("action name must be not empty")
+	case action.Text == "":
+		return errors.New("action text must be not empty")
+	case action.Type == "":
+		return errors.New("action type must be not empty")
+	}
+
+	a.Actions = append(a.Actions, action)
+	return nil
+}
+
+// ErrURLNotScrapable is matched by the error returned by EnrichURL when
+// the Open Graph data of the page can't be scraped, e.g. when the page doesn't exist.
+var ErrURLNotScrapable = errors.New("URL is not scrapable")
+
+// ogScrapeErrorPrefix starts the message of the API errors of failed scrapes.
+const ogScrapeErrorPrefix = "GetOG failed"
+
+// URLNotScrapableError is returned by EnrichURL when the page can't be scraped.
+// It matches ErrURLNotScrapable and wraps the API error.
+type URLNotScrapableError struct {
+	Err Error
+}
+
+func (e *URLNotScrapableError) Error() string {
+	return ErrURLNotScrapable.Error() + ": " + e.Err.Message
+}
+
+func (e *URLNotScrapableError) Is(target error) bool {
+	return target == ErrURLNotScrapable
+}
+
+func (e *URLNotScrapableError) Unwrap() error {
+	return e.Err
+}
+
+// enrichURLResponse is the response of the og endpoint, which returns
+// the attachment at the top level, next to the response metadata.
+type enrichURLResponse struct {
+	attachmentForJSON
+	Duration string `json:"duration"`
+	Response
+}
+
+type enrichURLResponseForJSON enrichURLResponse
+
+// UnmarshalJSON implements json.Unmarshaler. It decodes into the existing
+// response, so the rate limit information can be added afterwards.
+func (r *enrichURLResponse) UnmarshalJSON(data []byte) error {
+	if err := json.Unmarshal(data, (*enrichURLResponseForJSON)(r)); err != nil {
+		return err
+	}
+
+	var extra map[string]interface{}
+	if err := json.Unmarshal(data, &extra); err != nil {
+		return err
+	}
+	removeFromMap(extra, *r)
+	if len(extra) > 0 {
+		r.ExtraData = extra
+	}
+	return nil
+}
+
+// EnrichURL scrapes the Open Graph data of the page at targetURL and returns it as an attachment,
+// which can be used to build a link preview. The site name is set as AuthorName and the
+// description as Text. If the page can't be scraped, a *URLNotScrapableError matching
+// ErrURLNotScrapable is returned.
+func (c *Client) EnrichURL(ctx context.Context, targetURL string) (*Attachment, error) {
+	if targetURL == "" {
+		return nil, errors.New("URL must be not empty")
+	}
+
+	params := url.Values{}
+	params.Set("url", targetURL)
+
+	var resp enrichURLResponse
+	err := c.makeRequest(ctx, http.MethodGet, "og", params, nil, &resp)
+	if err != nil {
+		var apiErr Error
+		if errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusBadRequest &&
+			strings.HasPrefix(apiErr.Message, ogScrapeErrorPrefix) {
+			return nil, &URLNotScrapableError{Err: apiErr}
+		}
+		return nil, err
+	}
+
+	a := Attachment(resp.attachmentForJSON)
+	return &a, nil
+}
@@ -0,0 +1,76 @@
+package stream_chat
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewImageAttachment(t *testing.T) {
+	_, err := NewImageAttachment("", "fallback")
+	require.Error(t, err)
+
+	a, err := NewImageAttachment("https://example.com/image.png", "an image")
+	require.NoError(t, err)
+	require.Equal(t, &Attachment{
+		Type:     AttachmentTypeImage,
+		ImageURL: "https://example.com/image.png",
+		Fallback: "an image",
+	}, a)
+}
+
+func TestNewFileAttachment(t *testing.T) {
+	_, err := NewFileAttachment("", "report", "application/pdf", 10)
+	require.Error(t, err)
+	_, err = NewFileAttachment("https://example.com/report.pdf", "report", "application/pdf", -1)
+	require.Error(t, err)
+
+	a, err := NewFileAttachment("https://example.com/report.pdf", "report", "application/pdf", 1024)
+	require.NoError(t, err)
+	require.Equal(t, AttachmentTypeFile, a.Type)
+	require.Equal(t, "https://example.com/report.pdf", a.AssetURL)
+	require.EqualValues(t, 1024, a.FileSize)
+}
+
+func TestNewGiphyAttachment(t *testing.T) {
+	_, err := NewGiphyAttachment("cat", "", "", "")
+	require.Error(t, err)
+
+	a, err := NewGiphyAttachment("cat", "https://giphy.com/cat", "https://media.giphy.com/cat.gif", "")
+	require.NoError(t, err)
+	require.Equal(t, AttachmentTypeGiphy, a.Type)
+	require.Equal(t, "https://media.giphy.com/cat.gif", a.ImageURL)
+}
+
+func TestAttachment_AddAction(t *testing.T) {
+	a, err := NewGiphyAttachment("cat", "", "https://media.giphy.com/cat.gif", "")
+	require.NoError(t, err)
+
+	require.Error(t, a.AddAction(nil))
+	require.Error(t, a.AddAction(&AttachmentAction{Name: "image_action", Type: "button"}))
+
+	require.NoError(t, a.AddAction(&AttachmentAction{Name: "image_action", Text: "Send", Type: "button", Style: "primary", Value: "send"}))
+	require.NoError(t, a.AddAction(&AttachmentAction{Name: "image_action", Text: "Cancel", Type: "button", Value: "cancel"}))
+	require.Len(t, a.Actions, 2)
+
+	msg := &Message{Attachments: []*Attachment{a}}
+	require.Len(t, msg.Attachments[0].Actions, 2)
+}
+
+func TestClient_EnrichURL(t *testing.T) {
+	c := initClient(t)
+	ctx := context.Background()
+
+	a, err := c.EnrichURL(ctx, "https://getstream.io")
+	require.NoError(t, err)
+	require.NotEmpty(t, a.Title)
+	require.Equal(t, "https://getstream.io", a.OGScrapeURL)
+
+	_, err = c.EnrichURL(ctx, "https://"+randomString(12)+".invalid")
+	require.ErrorIs(t, err, ErrURLNotScrapable)
+
+	_, err = c.EnrichURL(ctx, "")
+	require.Error(t, err)
+	require.NotErrorIs(t, err, ErrURLNotScrapable)
+}
@@ -6,10 +6,12 @@ import (
 	"errors"
 	"net/http"
 	"net/url"
+	"strings"
 	"time"
 )
 
-// BanUser bans targetID.
+// BanUser bans targetID from all channels of the app.
+// Use Channel.BanUser or BanUserWithOptions with a ChannelCID to ban targetID from a single channel.
 func (c *Client) BanUser(ctx context.Context, targetID, bannedBy string, options ...BanOption) (*Response, error) {
 	switch {
 	case targetID == "":
@@ -32,6 +34,50 @@ func (c *Client) BanUser(ctx context.Context, targetID, bannedBy string, options
 	return &resp, err
 }
 
+// BanOptions are the options of BanUserWithOptions.
+type BanOptions struct {
+	// ChannelCID restricts the ban to the channel with the given CID, e.g. "messaging:general".
+	// If empty, the user is banned from all channels of the app.
+	ChannelCID string
+	Reason     string
+	// Timeout is the duration of the ban, which is sent in minutes. Zero means the ban never expires.
+	Timeout time.Duration
+	// Shadow makes a shadow ban: the banned user's messages are only visible to themselves.
+	Shadow bool
+}
+
+// BanUserWithOptions bans targetID globally, or from a single channel if opts.ChannelCID is set.
+func (c *Client) BanUserWithOptions(ctx context.Context, targetID, bannedBy string, opts *BanOptions) (*Response, error) {
+	if opts == nil {
+		opts = &BanOptions{}
+	}
+
+	var options []BanOption
+	if opts.ChannelCID != "" {
+		parts := strings.SplitN(opts.ChannelCID, ":", 2)
+		if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+			return nil, errors.New("channel CID must be of the form type:id")
+		}
+		options = append(options, banFromChannel(parts[0], parts[1]))
+	}
+	if opts.Reason != "" {
+		options = append(options, BanWithReason(opts.Reason))
+	}
+	switch {
+	case opts.Timeout < 0:
+		return nil, errors.New("timeout must not be negative")
+	case opts.Timeout > 0 && opts.Timeout < time.Minute:
+		return nil, errors.New("timeout should be at least a minute")
+	case opts.Timeout > 0:
+		options = append(options, BanWithExpiration(int(opts.Timeout.Minutes())))
+	}
+	if opts.Shadow {
+		options = append(options, banWithShadow())
+	}
+
+	return c.BanUser(ctx, targetID, bannedBy, options...)
+}
+
 // UnBanUser removes the ban for targetID.
 func (c *Client) UnBanUser(ctx context.Context, targetID string) (*Response, error) {
 	if targetID == "" {
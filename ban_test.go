@@ -3,6 +3,7 @@ package stream_chat
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 )
@@ -131,6 +132,40 @@ func TestChannelBanUnban(t *testing.T) {
 	require.Empty(t, resp.Bans)
 }
 
+func TestClient_BanUserWithOptions(t *testing.T) {
+	c := initClient(t)
+	target := randomUser(t, c)
+	user := randomUser(t, c)
+	ch := initChannel(t, c, user.ID, target.ID)
+	ctx := context.Background()
+
+	_, err := c.BanUserWithOptions(ctx, target.ID, user.ID, &BanOptions{ChannelCID: "invalid"})
+	require.Error(t, err)
+
+	_, err = c.BanUserWithOptions(ctx, target.ID, user.ID, &BanOptions{
+		ChannelCID: ch.CID,
+		Reason:     "spammer",
+		Timeout:    time.Hour,
+		Shadow:     true,
+	})
+	require.NoError(t, err)
+
+	resp, err := c.QueryBannedUsers(ctx, &QueryBannedUsersOptions{
+		QueryOption: &QueryOption{Filter: map[string]interface{}{
+			"channel_cid": map[string]string{"$eq": ch.CID},
+		}},
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.Bans, 1)
+	require.Equal(t, target.ID, resp.Bans[0].User.ID)
+	require.Equal(t, "spammer", resp.Bans[0].Reason)
+	require.True(t, resp.Bans[0].Shadow)
+	require.NotNil(t, resp.Bans[0].Expires)
+
+	_, err = ch.UnBanUser(ctx, target.ID)
+	require.NoError(t, err)
+}
+
 func ExampleClient_BanUser() {
 	client, _ := NewClient("XXXX", "XXXX")
 	ctx := context.Background()
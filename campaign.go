@@ -0,0 +1,166 @@
+package stream_chat
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"path"
+	"time"
+)
+
+type CampaignStatus string
+
+const (
+	CampaignStatusDraft     CampaignStatus = "draft"
+	CampaignStatusScheduled CampaignStatus = "scheduled"
+	CampaignStatusRunning   CampaignStatus = "running"
+	CampaignStatusStopped   CampaignStatus = "stopped"
+	CampaignStatusCompleted CampaignStatus = "completed"
+)
+
+// CampaignMessageTemplate is the message sent to every target of a campaign.
+type CampaignMessageTemplate struct {
+	Text        string                 `json:"text"`
+	Attachments []*Attachment          `json:"attachments,omitempty"`
+	Custom      map[string]interface{} `json:"custom,omitempty"`
+}
+
+type CampaignStats struct {
+	Progress         float64    `json:"progress"`
+	MessagesSent     int        `json:"stats_messages_sent"`
+	ChannelsCreated  int        `json:"stats_channels_created"`
+	UsersSent        int        `json:"stats_users_sent"`
+	UsersRead        int        `json:"stats_users_read"`
+	CompletedAt      *time.Time `json:"stats_completed_at,omitempty"`
+	StartedAt        *time.Time `json:"stats_started_at,omitempty"`
+	ServerSideErrors int        `json:"stats_server_side_errors"`
+}
+
+// Campaign sends a message on behalf of SenderID to the targets of its segments.
+type Campaign struct {
+	ID              string                   `json:"id,omitempty"`
+	Name            string                   `json:"name"`
+	Description     string                   `json:"description,omitempty"`
+	SegmentIDs      []string                 `json:"segment_ids"`
+	SenderID        string                   `json:"sender_id"`
+	MessageTemplate *CampaignMessageTemplate `json:"message_template"`
+	// CreateChannels creates the channels between the sender and the targets which don't exist yet.
+	CreateChannels bool `json:"create_channels,omitempty"`
+	SkipPush       bool `json:"skip_push,omitempty"`
+	SkipWebhook    bool `json:"skip_webhook,omitempty"`
+
+	Status       CampaignStatus `json:"status,omitempty"`
+	Stats        *CampaignStats `json:"stats,omitempty"`
+	ScheduledFor *time.Time     `json:"scheduled_for,omitempty"`
+
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+	UpdatedAt *time.Time `json:"updated_at,omitempty"`
+}
+
+type CampaignResponse struct {
+	Campaign *Campaign `json:"campaign"`
+	Response
+}
+
+// CreateCampaign creates a campaign and returns it. The campaign is sent once it is started.
+func (c *Client) CreateCampaign(ctx context.Context, campaign *Campaign) (*CampaignResponse, error) {
+	switch {
+	case campaign == nil:
+		return nil, errors.New("campaign is nil")
+	case campaign.SenderID == "":
+		return nil, errors.New("campaign sender ID must be not empty")
+	case len(campaign.SegmentIDs) == 0:
+		return nil, errors.New("campaign segment IDs are empty")
+	case campaign.MessageTemplate == nil:
+		return nil, errors.New("campaign message template is nil")
+	}
+
+	var resp CampaignResponse
+	err := c.makeRequest(ctx, http.MethodPost, "campaigns", nil, campaign, &resp)
+	return &resp, err
+}
+
+// GetCampaign returns the campaign with given ID, including its status and stats.
+func (c *Client) GetCampaign(ctx context.Context, id string) (*CampaignResponse, error) {
+	if id == "" {
+		return nil, errors.New("campaign ID must be not empty")
+	}
+
+	p := path.Join("campaigns", url.PathEscape(id))
+
+	var resp CampaignResponse
+	err := c.makeRequest(ctx, http.MethodGet, p, nil, nil, &resp)
+	return &resp, err
+}
+
+// StartCampaign starts the campaign with given ID. If scheduledFor is set, the campaign
+// is scheduled to start at that time, otherwise it starts immediately.
+func (c *Client) StartCampaign(ctx context.Context, id string, scheduledFor *time.Time) (*CampaignResponse, error) {
+	if id == "" {
+		return nil, errors.New("campaign ID must be not empty")
+	}
+
+	p := path.Join("campaigns", url.PathEscape(id), "start")
+
+	data := map[string]interface{}{}
+	if scheduledFor != nil {
+		data["scheduled_for"] = scheduledFor
+	}
+
+	var resp CampaignResponse
+	err := c.makeRequest(ctx, http.MethodPost, p, nil, data, &resp)
+	return &resp, err
+}
+
+// StopCampaign stops the campaign with given ID. Messages which are already sent are kept.
+func (c *Client) StopCampaign(ctx context.Context, id string) (*CampaignResponse, error) {
+	if id == "" {
+		return nil, errors.New("campaign ID must be not empty")
+	}
+
+	p := path.Join("campaigns", url.PathEscape(id), "stop")
+
+	var resp CampaignResponse
+	err := c.makeRequest(ctx, http.MethodPost, p, nil, nil, &resp)
+	return &resp, err
+}
+
+// DeleteCampaign deletes the campaign with given ID.
+func (c *Client) DeleteCampaign(ctx context.Context, id string) (*Response, error) {
+	if id == "" {
+		return nil, errors.New("campaign ID must be not empty")
+	}
+
+	p := path.Join("campaigns", url.PathEscape(id))
+
+	var resp Response
+	err := c.makeRequest(ctx, http.MethodDelete, p, nil, nil, &resp)
+	return &resp, err
+}
+
+type QueryCampaignsRequest struct {
+	Filter map[string]interface{} `json:"filter"`
+	Sort   []*SortOption          `json:"sort,omitempty"`
+	Limit  int                    `json:"limit,omitempty"`
+	Next   string                 `json:"next,omitempty"`
+	Prev   string                 `json:"prev,omitempty"`
+}
+
+type QueryCampaignsResponse struct {
+	Campaigns []*Campaign `json:"campaigns"`
+	Next      string      `json:"next,omitempty"`
+	Prev      string      `json:"prev,omitempty"`
+	Response
+}
+
+// QueryCampaigns returns the campaigns matching the given filter.
+func (c *Client) QueryCampaigns(ctx context.Context, req *QueryCampaignsRequest) (*QueryCampaignsResponse, error) {
+	if req == nil {
+		return nil, errors.New("request is nil")
+	}
+
+	var resp QueryCampaignsResponse
+	err := c.makeRequest(ctx, http.MethodPost, "campaigns/query", nil, req, &resp)
+	return &resp, err
+}
@@ -0,0 +1,50 @@
+package stream_chat
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_Campaigns(t *testing.T) {
+	c := initClient(t)
+	ctx := context.Background()
+	sender := randomUser(t, c)
+	target := randomUser(t, c)
+	segment := prepareSegment(t, c, target.ID)
+
+	resp, err := c.CreateCampaign(ctx, &Campaign{
+		Name:            randomString(10),
+		SenderID:        sender.ID,
+		SegmentIDs:      []string{segment.ID},
+		MessageTemplate: &CampaignMessageTemplate{Text: "Hello"},
+		CreateChannels:  true,
+	})
+	require.NoError(t, err)
+	campaign := resp.Campaign
+	t.Cleanup(func() {
+		_, _ = c.DeleteCampaign(ctx, campaign.ID)
+	})
+	require.Equal(t, CampaignStatusDraft, campaign.Status)
+
+	query, err := c.QueryCampaigns(ctx, &QueryCampaignsRequest{
+		Filter: map[string]interface{}{"id": campaign.ID},
+	})
+	require.NoError(t, err)
+	require.Len(t, query.Campaigns, 1)
+
+	_, err = c.StartCampaign(ctx, campaign.ID, nil)
+	require.NoError(t, err)
+
+	resp, err = c.StopCampaign(ctx, campaign.ID)
+	require.NoError(t, err)
+	require.Equal(t, campaign.ID, resp.Campaign.ID)
+
+	resp, err = c.GetCampaign(ctx, campaign.ID)
+	require.NoError(t, err)
+	require.NotEqual(t, CampaignStatusDraft, resp.Campaign.Status)
+
+	_, err = c.CreateCampaign(ctx, &Campaign{SenderID: sender.ID})
+	require.Error(t, err)
+}
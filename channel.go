@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"io"
 	"net/http"
 	"net/url"
@@ -26,9 +27,38 @@ type ChannelMember struct {
 	InviteAcceptedAt *time.Time `json:"invite_accepted_at,omitempty"`
 	InviteRejectedAt *time.Time `json:"invite_rejected_at,omitempty"`
 	Role             string     `json:"role,omitempty"`
+	ChannelRole      string     `json:"channel_role,omitempty"`
+
+	// NotificationsMuted is set when the member muted the push notifications of the channel.
+	NotificationsMuted bool `json:"notifications_muted,omitempty"`
 
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	UpdatedAt time.Time `json:"updated_at,omitempty"`
+
+	ExtraData map[string]interface{} `json:"-"`
+}
+
+type channelMemberForJSON ChannelMember
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (m *ChannelMember) UnmarshalJSON(data []byte) error {
+	var m2 channelMemberForJSON
+	if err := json.Unmarshal(data, &m2); err != nil {
+		return err
+	}
+	*m = ChannelMember(m2)
+
+	if err := json.Unmarshal(data, &m.ExtraData); err != nil {
+		return err
+	}
+
+	removeFromMap(m.ExtraData, *m)
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler.
+func (m ChannelMember) MarshalJSON() ([]byte, error) {
+	return addToMapAndMarshal(m.ExtraData, channelMemberForJSON(m))
 }
 
 type Channel struct {
@@ -42,6 +72,7 @@ type Channel struct {
 	CreatedBy *User `json:"created_by"`
 	Disabled  bool  `json:"disabled"`
 	Frozen    bool  `json:"frozen"`
+	Cooldown  int   `json:"cooldown"` // slow mode interval in seconds, 0 if disabled
 
 	MemberCount int              `json:"member_count"`
 	Members     []*ChannelMember `json:"members"`
@@ -50,6 +81,9 @@ type Channel struct {
 	PinnedMessages []*Message     `json:"pinned_messages"`
 	Read           []*ChannelRead `json:"read"`
 
+	Watchers     []*User `json:"watchers,omitempty"`
+	WatcherCount int     `json:"watcher_count,omitempty"`
+
 	CreatedAt     time.Time `json:"created_at"`
 	UpdatedAt     time.Time `json:"updated_at"`
 	LastMessageAt time.Time `json:"last_message_at"`
@@ -103,6 +137,8 @@ type QueryResponse struct {
 	PinnedMessages []*Message       `json:"pinned_messages,omitempty"`
 	Members        []*ChannelMember `json:"members,omitempty"`
 	Read           []*ChannelRead   `json:"read,omitempty"`
+	Watchers       []*User          `json:"watchers,omitempty"`
+	WatcherCount   int              `json:"watcher_count,omitempty"`
 
 	Response
 }
@@ -194,7 +230,13 @@ func (q QueryResponse) updateChannel(ch *Channel) {
 }
 
 // Query makes request to channel api and updates channel internal state.
+// Messages, members and watchers of the channel can be paginated separately, e.g.
+// to load the latest 20 messages and the first 10 members in a single call.
 func (ch *Channel) Query(ctx context.Context, q *QueryRequest) (*QueryResponse, error) {
+	if q == nil {
+		q = &QueryRequest{}
+	}
+
 	p := path.Join("channels", url.PathEscape(ch.Type), url.PathEscape(ch.ID), "query")
 
 	var resp QueryResponse
@@ -208,6 +250,60 @@ func (ch *Channel) Query(ctx context.Context, q *QueryRequest) (*QueryResponse,
 	return &resp, nil
 }
 
+// QueryChannelOptions configures QueryWithOptions.
+type QueryChannelOptions struct {
+	// State returns the channel state: messages, members, watchers and reads.
+	State bool
+	// Watch subscribes the user to the channel events, it requires a connected user.
+	Watch bool
+	// Presence subscribes the user to the presence changes of the members.
+	Presence bool
+
+	// Messages, Members and Watchers paginate the returned state, e.g.
+	// Messages.Limit and Messages.IDLT to load older messages.
+	Messages *MessagePaginationParamsRequest
+	Members  *PaginationParamsRequest
+	Watchers *PaginationParamsRequest
+}
+
+// QueryWithOptions queries the channel and returns it populated with the requested
+// messages, members, watchers and reads. Unlike Query the receiver is left unchanged.
+func (ch *Channel) QueryWithOptions(ctx context.Context, opts *QueryChannelOptions) (*Channel, error) {
+	if opts == nil {
+		opts = &QueryChannelOptions{}
+	}
+
+	q := QueryRequest{
+		State:    opts.State,
+		Watch:    opts.Watch,
+		Presence: opts.Presence,
+		Messages: opts.Messages,
+		Members:  opts.Members,
+		Watchers: opts.Watchers,
+	}
+
+	p := path.Join("channels", url.PathEscape(ch.Type), url.PathEscape(ch.ID), "query")
+
+	var resp QueryResponse
+	err := ch.client.makeRequest(ctx, http.MethodPost, p, nil, q, &resp)
+	if err != nil {
+		return nil, err
+	}
+	if resp.Channel == nil {
+		return nil, errors.New("channel is missing from the response")
+	}
+
+	result := resp.Channel
+	result.client = ch.client
+	result.Members = resp.Members
+	result.Messages = resp.Messages
+	result.PinnedMessages = resp.PinnedMessages
+	result.Read = resp.Read
+	result.Watchers = resp.Watchers
+	result.WatcherCount = resp.WatcherCount
+	return result, nil
+}
+
 // Update edits the channel's custom properties.
 //
 // properties: the object to update the custom properties of this channel with
@@ -227,6 +323,12 @@ func (ch *Channel) Update(ctx context.Context, properties map[string]interface{}
 	return &resp, err
 }
 
+type UpdateChannelResponse struct {
+	Channel *Channel         `json:"channel"`
+	Members []*ChannelMember `json:"members"`
+	Response
+}
+
 // PartialUpdate set and unset specific fields when it is necessary to retain additional custom data fields on the object. AKA a patch style update.
 func (ch *Channel) PartialUpdate(ctx context.Context, update PartialUpdate) (*Response, error) {
 	p := path.Join("channels", url.PathEscape(ch.Type), url.PathEscape(ch.ID))
@@ -236,6 +338,60 @@ func (ch *Channel) PartialUpdate(ctx context.Context, update PartialUpdate) (*Re
 	return &resp, err
 }
 
+// PartialUpdateChannel sets and unsets the given fields like PartialUpdate and returns
+// the updated channel with its members. The receiver is left unchanged.
+func (ch *Channel) PartialUpdateChannel(ctx context.Context, set map[string]interface{}, unset []string) (*Channel, error) {
+	if len(set) == 0 && len(unset) == 0 {
+		return nil, errors.New("set or unset should not be empty")
+	}
+
+	p := path.Join("channels", url.PathEscape(ch.Type), url.PathEscape(ch.ID))
+
+	var resp UpdateChannelResponse
+	err := ch.client.makeRequest(ctx, http.MethodPatch, p, nil, PartialUpdate{Set: set, Unset: unset}, &resp)
+	if err != nil {
+		return nil, err
+	}
+	if resp.Channel == nil {
+		return nil, errors.New("channel is missing from the response")
+	}
+
+	updated := resp.Channel
+	updated.client = ch.client
+	updated.Members = resp.Members
+	return updated, nil
+}
+
+// maxSlowModeCooldown is the longest slow mode interval allowed by the API.
+const maxSlowModeCooldown = 120
+
+// EnableSlowMode enables slow mode on the channel, so members can send
+// at most one message every cooldownSeconds (1-120) seconds.
+func (ch *Channel) EnableSlowMode(ctx context.Context, cooldownSeconds int) (*Response, error) {
+	if cooldownSeconds < 1 || cooldownSeconds > maxSlowModeCooldown {
+		return nil, fmt.Errorf("cooldown must be between 1 and %d seconds", maxSlowModeCooldown)
+	}
+
+	resp, err := ch.PartialUpdate(ctx, PartialUpdate{
+		Set: map[string]interface{}{"cooldown": cooldownSeconds},
+	})
+	if err == nil {
+		ch.Cooldown = cooldownSeconds
+	}
+	return resp, err
+}
+
+// DisableSlowMode disables slow mode on the channel.
+func (ch *Channel) DisableSlowMode(ctx context.Context) (*Response, error) {
+	resp, err := ch.PartialUpdate(ctx, PartialUpdate{
+		Unset: []string{"cooldown"},
+	})
+	if err == nil {
+		ch.Cooldown = 0
+	}
+	return resp, err
+}
+
 // Delete removes the channel. Messages are permanently removed.
 func (ch *Channel) Delete(ctx context.Context) (*Response, error) {
 	p := path.Join("channels", url.PathEscape(ch.Type), url.PathEscape(ch.ID))
@@ -245,6 +401,21 @@ func (ch *Channel) Delete(ctx context.Context) (*Response, error) {
 	return &resp, err
 }
 
+// DeleteWithOptions removes the channel. If hardDelete is true, the channel
+// and all its data are removed irreversibly.
+func (ch *Channel) DeleteWithOptions(ctx context.Context, hardDelete bool) (*Response, error) {
+	p := path.Join("channels", url.PathEscape(ch.Type), url.PathEscape(ch.ID))
+
+	var params url.Values
+	if hardDelete {
+		params = url.Values{"hard_delete": []string{"true"}}
+	}
+
+	var resp Response
+	err := ch.client.makeRequest(ctx, http.MethodDelete, p, params, nil, &resp)
+	return &resp, err
+}
+
 type truncateOptions struct {
 	HardDelete  bool       `json:"hard_delete,omitempty"`
 	SkipPush    bool       `json:"skip_push,omitempty"`
@@ -308,6 +479,35 @@ func (ch *Channel) Truncate(ctx context.Context, options ...TruncateOption) (*Re
 		fn(option)
 	}
 
+	return ch.truncate(ctx, option)
+}
+
+// TruncateOptions are the options of TruncateWithOptions.
+type TruncateOptions struct {
+	// HardDelete removes the messages permanently instead of soft deleting them.
+	HardDelete bool
+	// SkipPush disables the push notification of the system message.
+	SkipPush bool
+	// TruncatedAt truncates only the messages sent up to this time.
+	TruncatedAt *time.Time
+	// Message is an optional system message announcing the truncation.
+	Message *Message
+	// MemberUserID is the ID of the member who truncates the channel.
+	MemberUserID string
+}
+
+// TruncateWithOptions removes the messages from the channel as described by opts.
+func (ch *Channel) TruncateWithOptions(ctx context.Context, opts TruncateOptions) (*Response, error) {
+	return ch.truncate(ctx, &truncateOptions{
+		HardDelete:  opts.HardDelete,
+		SkipPush:    opts.SkipPush,
+		TruncatedAt: opts.TruncatedAt,
+		Message:     opts.Message,
+		UserID:      opts.MemberUserID,
+	})
+}
+
+func (ch *Channel) truncate(ctx context.Context, option *truncateOptions) (*Response, error) {
 	p := path.Join("channels", url.PathEscape(ch.Type), url.PathEscape(ch.ID), "truncate")
 
 	var resp TruncateResponse
@@ -331,6 +531,26 @@ func (ch *Channel) GetMessages(ctx context.Context, messageIds []string) (*GetMe
 	return &resp, err
 }
 
+// maxGetManyMessagesIDs is the maximum number of message ids accepted by GetManyMessages.
+const maxGetManyMessagesIDs = 100
+
+// GetManyMessages returns the messages with the given ids, in the order returned by the server.
+// At most 100 ids can be requested at once.
+func (ch *Channel) GetManyMessages(ctx context.Context, messageIDs []string) ([]*Message, error) {
+	switch {
+	case len(messageIDs) == 0:
+		return nil, errors.New("message IDs are empty")
+	case len(messageIDs) > maxGetManyMessagesIDs:
+		return nil, fmt.Errorf("cannot get more than %d messages at once", maxGetManyMessagesIDs)
+	}
+
+	resp, err := ch.GetMessages(ctx, messageIDs)
+	if err != nil {
+		return nil, err
+	}
+	return resp.Messages, nil
+}
+
 type addMembersOptions struct {
 	MemberIDs []string `json:"add_members"`
 
@@ -380,6 +600,90 @@ func (ch *Channel) AddMembers(ctx context.Context, userIDs []string, options ...
 	return &resp, err
 }
 
+// ChannelMemberInput is a member to add with AddMembersX.
+type ChannelMemberInput struct {
+	UserID string
+	// ChannelRole is the channel role of the member, the channel type default is used if empty.
+	ChannelRole string
+}
+
+// AddMembersXOptions configures AddMembersX.
+type AddMembersXOptions struct {
+	// HideHistory hides the messages sent before the members joined, e.g. for private channels.
+	HideHistory bool
+	// Message is an optional system message sent with the update.
+	Message *Message
+}
+
+// AddMembersX adds members to the channel, each with an optional channel role.
+// The response contains the updated channel and its members, the receiver is left unchanged.
+func (ch *Channel) AddMembersX(ctx context.Context, members []ChannelMemberInput, opts AddMembersXOptions) (*UpdateChannelResponse, error) {
+	if len(members) == 0 {
+		return nil, errors.New("members are empty")
+	}
+
+	req := &addMembersOptions{
+		MemberIDs:   make([]string, 0, len(members)),
+		HideHistory: opts.HideHistory,
+		Message:     opts.Message,
+	}
+	for _, m := range members {
+		if m.UserID == "" {
+			return nil, errors.New("member user ID must be not empty")
+		}
+		req.MemberIDs = append(req.MemberIDs, m.UserID)
+		if m.ChannelRole != "" {
+			req.RolesAssignement = append(req.RolesAssignement, &RoleAssignment{UserID: m.UserID, ChannelRole: m.ChannelRole})
+		}
+	}
+
+	p := path.Join("channels", url.PathEscape(ch.Type), url.PathEscape(ch.ID))
+
+	var resp UpdateChannelResponse
+	err := ch.client.makeRequest(ctx, http.MethodPost, p, nil, req, &resp)
+	return &resp, err
+}
+
+// TeamMismatchError is returned when a user who doesn't belong to the channel's team
+// is added to a channel of a multi-tenant app.
+type TeamMismatchError struct {
+	UserID string
+	Team   string
+}
+
+func (e TeamMismatchError) Error() string {
+	return fmt.Sprintf("user %q is not a member of team %q", e.UserID, e.Team)
+}
+
+// AddTeamMembers adds the given users to the channel, like AddMembers does.
+// For multi-tenant apps, it first checks the users' teams against the channel's team
+// and returns a TeamMismatchError without calling the API if a user doesn't belong to it.
+// The check is skipped when the channel's team is not known.
+// The server enforces the same rule for AddMembers and replies with an Error in that case.
+func (ch *Channel) AddTeamMembers(ctx context.Context, users []*User, options ...AddMembersOptions) (*Response, error) {
+	userIDs := make([]string, 0, len(users))
+	for _, u := range users {
+		if u == nil {
+			return nil, errors.New("user is nil")
+		}
+		if ch.Team != "" && !containsString(u.Teams, ch.Team) {
+			return nil, TeamMismatchError{UserID: u.ID, Team: ch.Team}
+		}
+		userIDs = append(userIDs, u.ID)
+	}
+
+	return ch.AddMembers(ctx, userIDs, options...)
+}
+
+func containsString(ls []string, s string) bool {
+	for _, item := range ls {
+		if item == s {
+			return true
+		}
+	}
+	return false
+}
+
 // RemoveMembers deletes members with given IDs from the channel.
 func (ch *Channel) RemoveMembers(ctx context.Context, userIDs []string, message *Message) (*Response, error) {
 	if len(userIDs) == 0 {
@@ -414,13 +718,23 @@ type RoleAssignment struct {
 	ChannelRole string `json:"channel_role"`
 }
 
-// AssignRoles assigns roles to members with given IDs.
+// AssignRole assigns channel roles to members with given IDs, e.g. to hand the channel over
+// to a new owner when its creator leaves. Users who aren't members yet are added to the channel.
+// msg is an optional system message.
 func (ch *Channel) AssignRole(ctx context.Context, assignments []*RoleAssignment, msg *Message) (*Response, error) {
 	if len(assignments) == 0 {
 		return nil, errors.New("assignments are empty")
 	}
 	ids := make([]string, 0, len(assignments))
 	for _, a := range assignments {
+		switch {
+		case a == nil:
+			return nil, errors.New("assignment is nil")
+		case a.UserID == "":
+			return nil, errors.New("assignment user ID must be not empty")
+		case a.ChannelRole == "":
+			return nil, errors.New("assignment channel role must be not empty")
+		}
 		ids = append(ids, a.UserID)
 	}
 
@@ -478,6 +792,41 @@ func (ch *Channel) QueryMembers(ctx context.Context, q *QueryOption, sorters ...
 	return &resp, err
 }
 
+type channelMemberResponse struct {
+	ChannelMember *ChannelMember `json:"channel_member"`
+	Response
+}
+
+// PartialUpdateMember sets and unsets fields of the channel member with the given user ID,
+// e.g. the "channel_role" or custom member data, and returns the updated member.
+func (ch *Channel) PartialUpdateMember(ctx context.Context, userID string, set map[string]interface{}, unset []string) (*ChannelMember, error) {
+	switch {
+	case userID == "":
+		return nil, errors.New("user ID must be not empty")
+	case len(set) == 0 && len(unset) == 0:
+		return nil, errors.New("set or unset should not be empty")
+	}
+
+	p := path.Join("channels", url.PathEscape(ch.Type), url.PathEscape(ch.ID), "member")
+
+	params := url.Values{}
+	params.Set("user_id", userID)
+
+	var resp channelMemberResponse
+	err := ch.client.makeRequest(ctx, http.MethodPatch, p, params, PartialUpdate{Set: set, Unset: unset}, &resp)
+	if err != nil {
+		return nil, err
+	}
+	return resp.ChannelMember, nil
+}
+
+// SetMemberNotificationsMuted mutes or unmutes the push notifications of the channel for the
+// member with the given user ID, who stays in the channel and keeps receiving its messages.
+// It returns the updated member.
+func (ch *Channel) SetMemberNotificationsMuted(ctx context.Context, userID string, muted bool) (*ChannelMember, error) {
+	return ch.PartialUpdateMember(ctx, userID, map[string]interface{}{"notifications_muted": muted}, nil)
+}
+
 // AddModerators adds moderators with given IDs to the channel.
 func (ch *Channel) AddModerators(ctx context.Context, userIDs ...string) (*Response, error) {
 	return ch.addModerators(ctx, userIDs, nil)
@@ -514,7 +863,8 @@ func (ch *Channel) InviteMembers(ctx context.Context, userIDs ...string) (*Respo
 	return ch.inviteMembers(ctx, userIDs, nil)
 }
 
-// InviteMembers invites users with given IDs to the channel and produce system message.
+// InviteMembersWithMessage invites users with given IDs to the channel and produce system message.
+// Unlike AddMembers, the invited users become members only after they accept the invite.
 func (ch *Channel) InviteMembersWithMessage(ctx context.Context, userIDs []string, msg *Message) (*Response, error) {
 	return ch.inviteMembers(ctx, userIDs, msg)
 }
@@ -607,6 +957,28 @@ func (ch *Channel) MarkRead(ctx context.Context, userID string, options ...MarkR
 	return &resp, err
 }
 
+// MarkUnread marks the channel as unread for user with given ID,
+// starting from the message with given ID.
+func (ch *Channel) MarkUnread(ctx context.Context, userID, messageID string) (*Response, error) {
+	switch {
+	case userID == "":
+		return nil, errors.New("user ID must be not empty")
+	case messageID == "":
+		return nil, errors.New("message ID must be not empty")
+	}
+
+	p := path.Join("channels", url.PathEscape(ch.Type), url.PathEscape(ch.ID), "unread")
+
+	data := map[string]interface{}{
+		"user_id":    userID,
+		"message_id": messageID,
+	}
+
+	var resp Response
+	err := ch.client.makeRequest(ctx, http.MethodPost, p, nil, data, &resp)
+	return &resp, err
+}
+
 // RefreshState makes request to channel api and updates channel internal state.
 func (ch *Channel) RefreshState(ctx context.Context) (*QueryResponse, error) {
 	q := &QueryRequest{State: true}
@@ -657,6 +1029,24 @@ func (ch *Channel) hide(ctx context.Context, userID string, clearHistory bool) (
 	return &resp, err
 }
 
+// StopWatching stops watching the channel for userID, so the user no longer
+// receives the channel's events.
+func (ch *Channel) StopWatching(ctx context.Context, userID string) (*Response, error) {
+	if userID == "" {
+		return nil, errors.New("user ID must be not empty")
+	}
+
+	data := map[string]interface{}{
+		"user_id": userID,
+	}
+
+	p := path.Join("channels", url.PathEscape(ch.Type), url.PathEscape(ch.ID), "stop-watching")
+
+	var resp Response
+	err := ch.client.makeRequest(ctx, http.MethodPost, p, nil, data, &resp)
+	return &resp, err
+}
+
 type CreateChannelResponse struct {
 	Channel *Channel
 	*Response
@@ -751,7 +1141,7 @@ func (ch *Channel) DeleteImage(ctx context.Context, location string) (*Response,
 	return &resp, err
 }
 
-// AcceptInvite accepts an invite to the channel.
+// AcceptInvite accepts an invite to the channel. message is an optional system message.
 func (ch *Channel) AcceptInvite(ctx context.Context, userID string, message *Message) (*Response, error) {
 	if userID == "" {
 		return nil, errors.New("user ID must be not empty")
@@ -773,7 +1163,7 @@ func (ch *Channel) AcceptInvite(ctx context.Context, userID string, message *Mes
 	return &resp, err
 }
 
-// RejectInvite rejects an invite to the channel.
+// RejectInvite rejects an invite to the channel. message is an optional system message.
 func (ch *Channel) RejectInvite(ctx context.Context, userID string, message *Message) (*Response, error) {
 	if userID == "" {
 		return nil, errors.New("user ID must be not empty")
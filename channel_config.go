@@ -22,6 +22,8 @@ type ChannelConfig struct {
 	Uploads           bool `json:"uploads"`
 	URLEnrichment     bool `json:"url_enrichment"`
 	CustomEvents      bool `json:"custom_events"`
+	// retain the previous versions of edited messages, overrides the app setting if set
+	MessageHistory *bool `json:"message_history,omitempty"`
 
 	// number of days to keep messages, must be MessageRetentionForever or numeric string
 	MessageRetention string `json:"message_retention"`
@@ -6,6 +6,7 @@ import (
 	"os"
 	"path"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -35,6 +36,32 @@ func TestClient_TestQuery(t *testing.T) {
 	require.Equal(t, 1, len(resp.Members))
 }
 
+func TestChannel_QueryWithOptions(t *testing.T) {
+	c := initClient(t)
+	membersID := randomUsersID(t, c, 2)
+	ch := initChannel(t, c, membersID...)
+	ctx := context.Background()
+	first, err := ch.SendMessage(ctx, &Message{Text: "first"}, ch.CreatedBy.ID)
+	require.NoError(t, err)
+	second, err := ch.SendMessage(ctx, &Message{Text: "second"}, ch.CreatedBy.ID)
+	require.NoError(t, err)
+
+	got, err := ch.QueryWithOptions(ctx, &QueryChannelOptions{
+		State:    true,
+		Messages: &MessagePaginationParamsRequest{PaginationParamsRequest: PaginationParamsRequest{Limit: 1, IDLT: second.Message.ID}},
+		Members:  &PaginationParamsRequest{Limit: 1},
+	})
+	require.NoError(t, err)
+	require.Equal(t, ch.CID, got.CID)
+	require.Len(t, got.Messages, 1)
+	require.Equal(t, first.Message.ID, got.Messages[0].ID)
+	require.Len(t, got.Members, 1)
+
+	// the returned channel is usable for further calls
+	_, err = got.SendMessage(ctx, &Message{Text: "third"}, ch.CreatedBy.ID)
+	require.NoError(t, err)
+}
+
 func TestClient_CreateChannel(t *testing.T) {
 	c := initClient(t)
 	userID := randomUser(t, c).ID
@@ -109,6 +136,32 @@ func TestChannel_GetManyMessages(t *testing.T) {
 	require.Equal(t, messageResp.Message.ID, getMsgResp.Messages[0].ID)
 }
 
+func TestChannel_GetManyMessages_Limits(t *testing.T) {
+	ctx := context.Background()
+	c := initClient(t)
+	user := randomUser(t, c)
+	ch := initChannel(t, c, user.ID)
+
+	first, err := ch.SendMessage(ctx, &Message{Text: "first"}, user.ID)
+	require.NoError(t, err)
+	second, err := ch.SendMessage(ctx, &Message{Text: "second"}, user.ID)
+	require.NoError(t, err)
+
+	msgs, err := ch.GetManyMessages(ctx, []string{first.Message.ID, second.Message.ID})
+	require.NoError(t, err)
+	require.Len(t, msgs, 2)
+
+	_, err = ch.GetManyMessages(ctx, nil)
+	require.Error(t, err)
+
+	ids := make([]string, maxGetManyMessagesIDs+1)
+	for i := range ids {
+		ids[i] = randomString(10)
+	}
+	_, err = ch.GetManyMessages(ctx, ids)
+	require.Error(t, err)
+}
+
 func TestChannel_AddMembers(t *testing.T) {
 	c := initClient(t)
 	ctx := context.Background()
@@ -134,6 +187,55 @@ func TestChannel_AddMembers(t *testing.T) {
 	assert.Equal(t, user.ID, ch.Members[0].User.ID, "members contain user id")
 }
 
+func TestChannel_AddMembersX(t *testing.T) {
+	c := initClient(t)
+	ctx := context.Background()
+	ch := initChannel(t, c)
+	moderator := randomUser(t, c)
+	member := randomUser(t, c)
+
+	_, err := ch.AddMembersX(ctx, nil, AddMembersXOptions{})
+	require.Error(t, err)
+	_, err = ch.AddMembersX(ctx, []ChannelMemberInput{{}}, AddMembersXOptions{})
+	require.Error(t, err)
+
+	resp, err := ch.AddMembersX(ctx,
+		[]ChannelMemberInput{
+			{UserID: moderator.ID, ChannelRole: "channel_moderator"},
+			{UserID: member.ID},
+		},
+		AddMembersXOptions{
+			HideHistory: true,
+			Message:     &Message{Text: "members added", User: &User{ID: ch.CreatedBy.ID}},
+		},
+	)
+	require.NoError(t, err, "add members")
+	require.NotNil(t, resp.Channel)
+
+	roles := make(map[string]string)
+	for _, m := range resp.Members {
+		roles[m.UserID] = m.ChannelRole
+	}
+	assert.Equal(t, "channel_moderator", roles[moderator.ID])
+	assert.Contains(t, roles, member.ID)
+}
+
+func TestChannel_AddTeamMembers(t *testing.T) {
+	c := initClient(t)
+	ctx := context.Background()
+	ch := &Channel{Type: "messaging", ID: randomString(12), Team: "blue", client: c}
+
+	_, err := ch.AddTeamMembers(ctx, []*User{{ID: "red-user", Teams: []string{"red"}}})
+
+	var mismatch TeamMismatchError
+	require.ErrorAs(t, err, &mismatch)
+	require.Equal(t, "red-user", mismatch.UserID)
+	require.Equal(t, "blue", mismatch.Team)
+
+	_, err = ch.AddTeamMembers(ctx, []*User{nil})
+	require.Error(t, err)
+}
+
 func TestChannel_AssignRoles(t *testing.T) {
 	c := initClient(t)
 	ctx := context.Background()
@@ -149,6 +251,47 @@ func TestChannel_AssignRoles(t *testing.T) {
 	a := []*RoleAssignment{{ChannelRole: "channel_moderator", UserID: other.ID}}
 	_, err = ch.AssignRole(ctx, a, nil)
 	require.NoError(t, err)
+
+	_, err = ch.AssignRole(ctx, []*RoleAssignment{{UserID: other.ID}}, nil)
+	require.Error(t, err, "channel role is required")
+}
+
+func TestChannel_PartialUpdateMember(t *testing.T) {
+	c := initClient(t)
+	ctx := context.Background()
+
+	member := randomUser(t, c)
+	ch := initChannel(t, c, member.ID)
+
+	updated, err := ch.PartialUpdateMember(ctx, member.ID, map[string]interface{}{
+		"channel_role": "channel_moderator",
+		"color":        "red",
+	}, nil)
+	require.NoError(t, err)
+	require.Equal(t, member.ID, updated.UserID)
+	require.Equal(t, "channel_moderator", updated.ChannelRole)
+	require.Equal(t, "red", updated.ExtraData["color"])
+
+	updated, err = ch.PartialUpdateMember(ctx, member.ID, nil, []string{"color"})
+	require.NoError(t, err)
+	require.NotContains(t, updated.ExtraData, "color")
+}
+
+func TestChannel_SetMemberNotificationsMuted(t *testing.T) {
+	c := initClient(t)
+	ctx := context.Background()
+
+	member := randomUser(t, c)
+	ch := initChannel(t, c, member.ID)
+
+	updated, err := ch.SetMemberNotificationsMuted(ctx, member.ID, true)
+	require.NoError(t, err)
+	require.Equal(t, member.ID, updated.UserID)
+	require.True(t, updated.NotificationsMuted)
+
+	updated, err = ch.SetMemberNotificationsMuted(ctx, member.ID, false)
+	require.NoError(t, err)
+	require.False(t, updated.NotificationsMuted)
 }
 
 func TestChannel_QueryMembers(t *testing.T) {
@@ -224,6 +367,25 @@ func TestChannel_InviteMembers(t *testing.T) {
 	assert.Nil(t, ch.Members[0].InviteRejectedAt, "invite is not rejected")
 }
 
+func TestChannel_InviteAndAccept(t *testing.T) {
+	c := initClient(t)
+	ctx := context.Background()
+	ch := initChannel(t, c)
+	user := randomUser(t, c)
+
+	_, err := ch.InviteMembersWithMessage(ctx, []string{user.ID}, &Message{Text: "join us", User: &User{ID: ch.CreatedBy.ID}})
+	require.NoError(t, err, "invite members")
+
+	_, err = ch.AcceptInvite(ctx, user.ID, nil)
+	require.NoError(t, err, "accept invite")
+
+	resp, err := ch.QueryMembers(ctx, &QueryOption{Filter: map[string]interface{}{"id": user.ID}})
+	require.NoError(t, err)
+	require.Len(t, resp.Members, 1)
+	assert.True(t, resp.Members[0].Invited, "member was invited")
+	assert.NotNil(t, resp.Members[0].InviteAcceptedAt, "invite is accepted")
+}
+
 func TestChannel_Moderation(t *testing.T) {
 	c := initClient(t)
 	ctx := context.Background()
@@ -270,6 +432,19 @@ func TestChannel_Delete(t *testing.T) {
 	require.NoError(t, err, "delete channel")
 }
 
+func TestChannel_DeleteWithOptions(t *testing.T) {
+	c := initClient(t)
+	ch := initChannel(t, c)
+	ctx := context.Background()
+
+	_, err := ch.DeleteWithOptions(ctx, true)
+	require.NoError(t, err, "hard delete channel")
+
+	resp, err := c.QueryChannels(ctx, &QueryOption{Filter: map[string]interface{}{"cid": ch.CID}})
+	require.NoError(t, err)
+	require.Empty(t, resp.Channels, "channel is gone")
+}
+
 func TestChannel_GetReplies(t *testing.T) {
 	c := initClient(t)
 	ch := initChannel(t, c)
@@ -290,9 +465,91 @@ func TestChannel_GetReplies(t *testing.T) {
 	assert.Len(t, repliesResp.Messages, 1)
 }
 
+func TestChannel_GetReplies_Resume(t *testing.T) {
+	c := initClient(t)
+	ch := initChannel(t, c)
+	user := randomUser(t, c)
+	ctx := context.Background()
+
+	resp, err := ch.SendMessage(ctx, &Message{Text: "test message"}, user.ID, MessageSkipPush)
+	require.NoError(t, err, "send message")
+	parentID := resp.Message.ID
+
+	for i := 0; i < 2; i++ {
+		_, err = ch.SendMessage(ctx, &Message{Text: "test reply", ParentID: parentID}, user.ID, MessageSkipPush)
+		require.NoError(t, err, "send reply")
+	}
+
+	page, err := ch.GetReplies(ctx, parentID, nil)
+	require.NoError(t, err, "get replies")
+	require.Len(t, page.Messages, 2)
+	resume := page.ResumeOptions(10)
+
+	// a reply posted while disconnected
+	newReply, err := ch.SendMessage(ctx, &Message{Text: "new reply", ParentID: parentID}, user.ID, MessageSkipPush)
+	require.NoError(t, err, "send reply")
+
+	require.NotNil(t, resume)
+	require.Equal(t, 10, resume.Limit)
+
+	page, err = ch.GetRepliesPaginated(ctx, parentID, *resume)
+	require.NoError(t, err, "resume replies")
+	require.Len(t, page.Messages, 1)
+	require.Equal(t, newReply.Message.ID, page.Messages[0].ID)
+	require.Nil(t, (&RepliesResponse{}).ResumeOptions(10))
+}
+
+func TestChannel_GetRepliesPaginated(t *testing.T) {
+	c := initClient(t)
+	ch := initChannel(t, c)
+	user := randomUser(t, c)
+	ctx := context.Background()
+
+	resp, err := ch.SendMessage(ctx, &Message{Text: "test message"}, user.ID, MessageSkipPush)
+	require.NoError(t, err, "send message")
+	parentID := resp.Message.ID
+
+	replyIDs := make([]string, 0, 3)
+	for i := 0; i < 3; i++ {
+		reply, err := ch.SendMessage(ctx, &Message{Text: "test reply", ParentID: parentID}, user.ID, MessageSkipPush)
+		require.NoError(t, err, "send reply")
+		replyIDs = append(replyIDs, reply.Message.ID)
+	}
+
+	opts := RepliesOptions{Limit: 2}
+	page, err := ch.GetRepliesPaginated(ctx, parentID, opts)
+	require.NoError(t, err, "get replies")
+	require.Len(t, page.Messages, 2)
+	require.Equal(t, replyIDs[1:], []string{page.Messages[0].ID, page.Messages[1].ID})
+
+	older := page.OlderRepliesOptions(opts)
+	require.NotNil(t, older)
+	page, err = ch.GetRepliesPaginated(ctx, parentID, *older)
+	require.NoError(t, err, "get older replies")
+	require.Len(t, page.Messages, 1)
+	require.Equal(t, replyIDs[0], page.Messages[0].ID)
+	require.Nil(t, page.OlderRepliesOptions(*older))
+}
+
 func TestChannel_MarkRead(t *testing.T) {
 }
 
+func TestChannel_MarkUnread(t *testing.T) {
+	c := initClient(t)
+	user := randomUser(t, c)
+	ch := initChannel(t, c, user.ID)
+	ctx := context.Background()
+
+	resp, err := ch.SendMessage(ctx, &Message{Text: "test message"}, ch.CreatedBy.ID, MessageSkipPush)
+	require.NoError(t, err, "send message")
+
+	_, err = ch.MarkUnread(ctx, user.ID, "")
+	require.Error(t, err)
+
+	_, err = ch.MarkUnread(ctx, user.ID, resp.Message.ID)
+	require.NoError(t, err, "mark unread")
+}
+
 func TestChannel_RemoveMembers(t *testing.T) {
 	c := initClient(t)
 	ch := initChannel(t, c)
@@ -325,6 +582,35 @@ func TestChannel_SendEvent(t *testing.T) {
 		Type: "typing.start",
 	}, u.ID)
 	require.NoError(t, err)
+
+	parent, err := ch.SendMessage(ctx, &Message{Text: "parent"}, u.ID)
+	require.NoError(t, err)
+	_, err = ch.SendEvent(ctx, &Event{
+		Type:     "typing.start",
+		ParentID: parent.Message.ID,
+	}, u.ID)
+	require.NoError(t, err)
+}
+
+func TestChannel_SendEvents(t *testing.T) {
+	c := initClient(t)
+	u := randomUser(t, c)
+	ch := initChannel(t, c, u.ID)
+	ctx := context.Background()
+
+	events := make([]*Event, 0, 8)
+	for i := 0; i < 8; i++ {
+		events = append(events, &Event{Type: "typing.start"})
+	}
+
+	_, err := ch.SendEvents(ctx, events, u.ID)
+	require.NoError(t, err)
+
+	_, err = ch.SendEvents(ctx, []*Event{{Type: "typing.start"}, {Type: "message.new"}}, u.ID)
+	var sendErr *SendEventsError
+	require.ErrorAs(t, err, &sendErr)
+	require.Len(t, sendErr.Errors, 1)
+	require.Contains(t, sendErr.Errors, 1)
 }
 
 func TestChannel_SendMessage(t *testing.T) {
@@ -417,6 +703,31 @@ func TestChannel_TruncateWithOptions(t *testing.T) {
 	require.NotNil(t, ch.TruncatedAt)
 }
 
+func TestChannel_TruncateWithOptions_TruncatedAt(t *testing.T) {
+	c := initClient(t)
+	ch := initChannel(t, c)
+	user := randomUser(t, c)
+	ctx := context.Background()
+
+	_, err := ch.SendMessage(ctx, &Message{Text: "old message"}, user.ID)
+	require.NoError(t, err, "send message")
+	truncatedAt := time.Now()
+	time.Sleep(time.Second)
+	resp, err := ch.SendMessage(ctx, &Message{Text: "new message"}, user.ID)
+	require.NoError(t, err, "send message")
+
+	_, err = ch.TruncateWithOptions(ctx, TruncateOptions{
+		HardDelete:   true,
+		SkipPush:     true,
+		TruncatedAt:  &truncatedAt,
+		MemberUserID: user.ID,
+	})
+	require.NoError(t, err, "truncate channel")
+	require.NoError(t, ch.refresh(ctx), "refresh channel")
+	require.Len(t, ch.Messages, 1, "newer message is kept")
+	require.Equal(t, resp.Message.ID, ch.Messages[0].ID)
+}
+
 func TestChannel_Update(t *testing.T) {
 	c := initClient(t)
 	ch := initChannel(t, c)
@@ -455,6 +766,62 @@ func TestChannel_PartialUpdate(t *testing.T) {
 	require.Equal(t, nil, ch.ExtraData["age"])
 }
 
+func TestChannel_PartialUpdateChannel(t *testing.T) {
+	c := initClient(t)
+	ctx := context.Background()
+
+	req := &ChannelRequest{ExtraData: map[string]interface{}{"color": "blue", "age": 30}}
+	resp, err := c.CreateChannel(ctx, "team", randomString(12), randomUser(t, c).ID, req)
+	require.NoError(t, err)
+	ch := resp.Channel
+
+	_, err = ch.PartialUpdateChannel(ctx, nil, nil)
+	require.Error(t, err)
+
+	updated, err := ch.PartialUpdateChannel(ctx, map[string]interface{}{"color": "red"}, []string{"age"})
+	require.NoError(t, err)
+	require.Equal(t, ch.CID, updated.CID)
+	require.Equal(t, "red", updated.ExtraData["color"])
+	require.NotContains(t, updated.ExtraData, "age")
+	require.Equal(t, "blue", ch.ExtraData["color"], "receiver must not be modified")
+
+	// the returned channel is usable for further calls
+	_, err = updated.PartialUpdateChannel(ctx, nil, []string{"color"})
+	require.NoError(t, err)
+}
+
+func TestChannel_SlowMode(t *testing.T) {
+	c := initClient(t)
+	ch := initChannel(t, c)
+	ctx := context.Background()
+
+	_, err := ch.EnableSlowMode(ctx, 121)
+	require.Error(t, err)
+
+	_, err = ch.EnableSlowMode(ctx, 30)
+	require.NoError(t, err)
+	require.NoError(t, ch.refresh(ctx))
+	require.Equal(t, 30, ch.Cooldown)
+
+	_, err = ch.DisableSlowMode(ctx)
+	require.NoError(t, err)
+	require.NoError(t, ch.refresh(ctx))
+	require.Zero(t, ch.Cooldown)
+}
+
+func TestChannel_StopWatching(t *testing.T) {
+	c := initClient(t)
+	user := randomUser(t, c)
+	ch := initChannel(t, c, user.ID)
+	ctx := context.Background()
+
+	_, err := ch.StopWatching(ctx, "")
+	require.Error(t, err)
+
+	_, err = ch.StopWatching(ctx, user.ID)
+	require.NoError(t, err)
+}
+
 func TestChannel_AddModerators(t *testing.T) {
 }
 
@@ -169,6 +169,58 @@ func (c *Client) UpdateChannelType(ctx context.Context, name string, options map
 	return &resp, err
 }
 
+// UpdateChannelTypeGrants replaces the grants of the channel type with given name.
+// grants maps a role, e.g. "channel_member", to the IDs of the permissions it is granted,
+// e.g. "send-message". Roles which are not in grants keep their grants.
+func (c *Client) UpdateChannelTypeGrants(ctx context.Context, name string, grants map[string][]string) (*Response, error) {
+	switch {
+	case name == "":
+		return nil, errors.New("channel type name is empty")
+	case len(grants) == 0:
+		return nil, errors.New("grants are empty")
+	}
+
+	return c.UpdateChannelType(ctx, name, map[string]interface{}{"grants": grants})
+}
+
+// ChannelTypeFeatures toggles features of a channel type. Nil fields are left unchanged.
+type ChannelTypeFeatures struct {
+	// ReadEvents enables read state, including message.read events and webhooks.
+	ReadEvents *bool `json:"read_events,omitempty"`
+	// TypingEvents enables typing.start and typing.stop events.
+	TypingEvents *bool `json:"typing_events,omitempty"`
+	// ConnectEvents enables user presence events.
+	ConnectEvents *bool `json:"connect_events,omitempty"`
+	// Reactions enables message reactions.
+	Reactions *bool `json:"reactions,omitempty"`
+}
+
+// SetChannelTypeFeatures enables or disables the features of the channel type with given name.
+func (c *Client) SetChannelTypeFeatures(ctx context.Context, name string, features ChannelTypeFeatures) (*Response, error) {
+	switch {
+	case name == "":
+		return nil, errors.New("channel type name is empty")
+	case features == ChannelTypeFeatures{}:
+		return nil, errors.New("features are empty")
+	}
+
+	options := make(map[string]interface{}, 4)
+	if features.ReadEvents != nil {
+		options["read_events"] = *features.ReadEvents
+	}
+	if features.TypingEvents != nil {
+		options["typing_events"] = *features.TypingEvents
+	}
+	if features.ConnectEvents != nil {
+		options["connect_events"] = *features.ConnectEvents
+	}
+	if features.Reactions != nil {
+		options["reactions"] = *features.Reactions
+	}
+
+	return c.UpdateChannelType(ctx, name, options)
+}
+
 // DeleteChannelType deletes channel type.
 func (c *Client) DeleteChannelType(ctx context.Context, name string) (*Response, error) {
 	if name == "" {
@@ -180,3 +232,118 @@ func (c *Client) DeleteChannelType(ctx context.Context, name string) (*Response,
 	err := c.makeRequest(ctx, http.MethodDelete, p, nil, nil, &resp)
 	return &resp, err
 }
+
+// ChannelTypeExport is the portable definition of a channel type, which can be stored,
+// e.g. in version control, and imported into another app with ImportChannelType.
+type ChannelTypeExport struct {
+	ChannelConfig
+
+	Commands []string            `json:"commands"`
+	Grants   map[string][]string `json:"grants,omitempty"`
+}
+
+// ExportChannelType returns the definition of the channel type with given name,
+// including its settings, commands and grants.
+func (c *Client) ExportChannelType(ctx context.Context, name string) (*ChannelTypeExport, error) {
+	resp, err := c.GetChannelType(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	if resp.ChannelType == nil {
+		return nil, errors.New("unexpected error: channel type response is nil")
+	}
+
+	export := &ChannelTypeExport{
+		ChannelConfig: resp.ChannelType.ChannelConfig,
+		Commands:      make([]string, 0, len(resp.ChannelType.Commands)),
+		Grants:        resp.ChannelType.Grants,
+	}
+	for _, cmd := range resp.ChannelType.Commands {
+		export.Commands = append(export.Commands, cmd.Name)
+	}
+	return export, nil
+}
+
+// ImportChannelType creates the channel type of the export, or updates it if it
+// already exists, so importing the same export again has no effect.
+func (c *Client) ImportChannelType(ctx context.Context, export *ChannelTypeExport) (*Response, error) {
+	switch {
+	case export == nil:
+		return nil, errors.New("channel type export is nil")
+	case export.Name == "":
+		return nil, errors.New("channel type name is empty")
+	}
+
+	// all commands are enabled when none is given, like with CreateChannelType
+	commands := export.Commands
+	if len(commands) == 0 {
+		commands = []string{"all"}
+	}
+
+	_, err := c.GetChannelType(ctx, export.Name)
+	var apiErr Error
+	switch {
+	case errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusNotFound:
+		ct := &ChannelType{ChannelConfig: export.ChannelConfig, Grants: export.Grants}
+		for _, cmd := range commands {
+			ct.Commands = append(ct.Commands, &Command{Name: cmd})
+		}
+		resp, err := c.CreateChannelType(ctx, ct)
+		if err != nil {
+			return nil, err
+		}
+		return &resp.Response, nil
+	case err != nil:
+		return nil, err
+	}
+
+	return c.UpdateChannelType(ctx, export.Name, export.updateOptions(commands))
+}
+
+// updateOptions returns the UpdateChannelType options which set the channel type to the export.
+// The name identifies the channel type and can't be updated, unset moderation settings are left out.
+func (e *ChannelTypeExport) updateOptions(commands []string) map[string]interface{} {
+	cfg := e.ChannelConfig
+	options := map[string]interface{}{
+		"typing_events":      cfg.TypingEvents,
+		"read_events":        cfg.ReadEvents,
+		"connect_events":     cfg.ConnectEvents,
+		"search":             cfg.Search,
+		"reactions":          cfg.Reactions,
+		"reminders":          cfg.Reminders,
+		"replies":            cfg.Replies,
+		"mutes":              cfg.Mutes,
+		"push_notifications": cfg.PushNotifications,
+		"uploads":            cfg.Uploads,
+		"url_enrichment":     cfg.URLEnrichment,
+		"custom_events":      cfg.CustomEvents,
+		"max_message_length": cfg.MaxMessageLength,
+		"commands":           commands,
+	}
+
+	if cfg.MessageHistory != nil {
+		options["message_history"] = *cfg.MessageHistory
+	}
+	if cfg.MessageRetention != "" {
+		options["message_retention"] = cfg.MessageRetention
+	}
+	if cfg.Automod != "" {
+		options["automod"] = cfg.Automod
+	}
+	if cfg.ModBehavior != "" {
+		options["automod_behavior"] = cfg.ModBehavior
+	}
+	if cfg.AutomodThresholds != nil {
+		options["automod_thresholds"] = cfg.AutomodThresholds
+	}
+	if cfg.BlockList != "" {
+		options["blocklist"] = cfg.BlockList
+	}
+	if cfg.BlockListBehavior != "" {
+		options["blocklist_behavior"] = cfg.BlockListBehavior
+	}
+	if e.Grants != nil {
+		options["grants"] = e.Grants
+	}
+	return options
+}
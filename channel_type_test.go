@@ -71,6 +71,74 @@ func TestClient_UpdateChannelTypePushNotifications(t *testing.T) {
 	require.False(t, resp.ChannelType.PushNotifications)
 }
 
+func TestClient_UpdateChannelTypeGrants(t *testing.T) {
+	c := initClient(t)
+	ct := prepareChannelType(t, c)
+	ctx := context.Background()
+
+	grants := map[string][]string{"guest": {"read-channel"}}
+	_, err := c.UpdateChannelTypeGrants(ctx, ct.Name, grants)
+	require.NoError(t, err)
+
+	resp, err := c.GetChannelType(ctx, ct.Name)
+	require.NoError(t, err)
+	assert.Equal(t, grants["guest"], resp.ChannelType.Grants["guest"])
+
+	_, err = c.UpdateChannelTypeGrants(ctx, ct.Name, nil)
+	require.Error(t, err)
+}
+
+func TestClient_SetChannelTypeFeatures(t *testing.T) {
+	c := initClient(t)
+	ct := prepareChannelType(t, c)
+	ctx := context.Background()
+
+	disabled := false
+	_, err := c.SetChannelTypeFeatures(ctx, ct.Name, ChannelTypeFeatures{
+		ReadEvents:   &disabled,
+		TypingEvents: &disabled,
+	})
+	require.NoError(t, err)
+
+	resp, err := c.GetChannelType(ctx, ct.Name)
+	require.NoError(t, err)
+	assert.False(t, resp.ChannelType.ReadEvents)
+	assert.False(t, resp.ChannelType.TypingEvents)
+	assert.Equal(t, ct.Reactions, resp.ChannelType.Reactions, "unset features are unchanged")
+
+	_, err = c.SetChannelTypeFeatures(ctx, ct.Name, ChannelTypeFeatures{})
+	require.Error(t, err, "no features to set")
+	_, err = c.SetChannelTypeFeatures(ctx, "", ChannelTypeFeatures{ReadEvents: &disabled})
+	require.Error(t, err, "name is required")
+}
+
+func TestClient_ExportImportChannelType(t *testing.T) {
+	c := initClient(t)
+	ct := prepareChannelType(t, c)
+	ctx := context.Background()
+
+	export, err := c.ExportChannelType(ctx, ct.Name)
+	require.NoError(t, err)
+	require.NotEmpty(t, export.Grants)
+
+	export.Name = randomString(10)
+	export.Reactions = false
+	_, err = c.ImportChannelType(ctx, export)
+	require.NoError(t, err, "import creates the channel type")
+	t.Cleanup(func() {
+		_, _ = c.DeleteChannelType(ctx, export.Name)
+	})
+
+	_, err = c.ImportChannelType(ctx, export)
+	require.NoError(t, err, "import again updates the channel type")
+
+	imported, err := c.ExportChannelType(ctx, export.Name)
+	require.NoError(t, err)
+	assert.False(t, imported.Reactions)
+	assert.ElementsMatch(t, export.Commands, imported.Commands)
+	assert.Equal(t, export.Grants, imported.Grants)
+}
+
 // See https://getstream.io/chat/docs/channel_features/ for more details.
 func ExampleClient_CreateChannelType() {
 	client := &Client{}
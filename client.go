@@ -5,6 +5,7 @@ import (
 	"context"
 	"crypto"
 	"crypto/hmac"
+	"crypto/rand"
 	"encoding/hex"
 	"encoding/json"
 	"errors"
@@ -17,6 +18,7 @@ import (
 	"os"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/golang-jwt/jwt/v4"
@@ -37,16 +39,100 @@ type Client struct {
 	apiKey    string
 	apiSecret []byte
 	authToken string
+	timeout   time.Duration
+
+	requestHooks  []RequestHook
+	tracer        Tracer
+	useJSONNumber bool
+	tokenCache    *tokenCache
+	keepRawBody   bool
+
+	mu       sync.RWMutex
+	closed   bool
+	inflight sync.WaitGroup
 }
 
+// ErrClientClosed is returned by API calls made after Close.
+var ErrClientClosed = errors.New("client is closed")
+
 type ClientOption func(c *Client)
 
+// WithRequestHook registers a hook which is called after every API request.
+// Hooks are called in the order they are registered. A nil hook is ignored.
+func WithRequestHook(hook RequestHook) func(c *Client) {
+	return func(c *Client) {
+		if hook != nil {
+			c.requestHooks = append(c.requestHooks, hook)
+		}
+	}
+}
+
+// RequestLogger receives the method, path, status code and latency of an API request.
+// status is zero if no response was received.
+type RequestLogger func(ctx context.Context, method, path string, status int, duration time.Duration, err error)
+
+// WithRequestLogger registers a logger which is called after every API request.
+// A nil logger is ignored.
+func WithRequestLogger(logger RequestLogger) func(c *Client) {
+	if logger == nil {
+		return func(*Client) {}
+	}
+	return WithRequestHook(func(ctx context.Context, info RequestInfo) {
+		logger(ctx, info.Method, info.Path, info.StatusCode, info.Duration, info.Err)
+	})
+}
+
+// WithUseJSONNumber makes the client decode numbers in the ExtraData of the returned
+// objects, e.g. messages, users, channels and events, as json.Number instead of float64,
+// so large integers don't lose precision.
+func WithUseJSONNumber() func(c *Client) {
+	return func(c *Client) {
+		c.useJSONNumber = true
+	}
+}
+
+// WithKeepRawBody makes the client keep the undecoded body of every
+// successful response in Response.RawBody.
+func WithKeepRawBody() func(c *Client) {
+	return func(c *Client) {
+		c.keepRawBody = true
+	}
+}
+
+// WithTokenCache makes CreateToken cache the signed tokens in memory, keyed by
+// user ID, expiration and issued at time. Expired tokens are never returned from the cache,
+// and the least recently used tokens are evicted once it holds 10000 tokens.
+// Tokens are only reused when the same expiration is passed again, e.g. truncated to the hour,
+// an expiration computed from time.Now on each call never hits the cache.
+func WithTokenCache() func(c *Client) {
+	return func(c *Client) {
+		c.tokenCache = newTokenCache(maxCachedTokens)
+	}
+}
+
+// WithTimeout sets the default timeout of API requests.
+// The deadline of the context passed to an API call applies as well, whichever is sooner.
+// Use ContextWithRequestTimeout to give slow calls (e.g. exports) more time than the default:
+//
+//	ctx := stream.ContextWithRequestTimeout(ctx, time.Minute)
+//	resp, err := client.ExportChannels(ctx, channels, nil)
+//
+// A zero timeout disables the default timeout.
 func WithTimeout(t time.Duration) func(c *Client) {
 	return func(c *Client) {
-		c.HTTP.Timeout = t
+		c.timeout = t
 	}
 }
 
+type requestTimeoutKey struct{}
+
+// ContextWithRequestTimeout returns a copy of ctx which makes the API calls made with it
+// use timeout instead of the default timeout of the client. The deadline of ctx, if any,
+// still applies when it is sooner. A zero timeout disables the default timeout for these calls.
+func ContextWithRequestTimeout(ctx context.Context, timeout time.Duration) context.Context {
+	return context.WithValue(ctx, requestTimeoutKey{}, timeout)
+}
+
 // NewClientFromEnvVars creates a new Client where the API key
 // is retrieved from STREAM_KEY and the secret from STREAM_SECRET
 // environmental variables.
@@ -85,9 +171,9 @@ func NewClient(apiKey, apiSecret string, options ...ClientOption) (*Client, erro
 	client := &Client{
 		apiKey:    apiKey,
 		apiSecret: []byte(apiSecret),
+		timeout:   timeout,
 		BaseURL:   baseURL,
 		HTTP: &http.Client{
-			Timeout:   timeout,
 			Transport: tr,
 		},
 	}
@@ -107,6 +193,8 @@ func NewClient(apiKey, apiSecret string, options ...ClientOption) (*Client, erro
 }
 
 // SetClient sets a new underlying HTTP client.
+// The timeout of the HTTP client, if any, applies in addition to the
+// client's default timeout and the deadline of the request context.
 func (c *Client) SetClient(client *http.Client) {
 	c.HTTP = client
 }
@@ -126,30 +214,114 @@ func (c *Client) Permissions() *PermissionClient {
 	return &PermissionClient{client: c}
 }
 
+// Close stops the client from accepting new requests, waits for in-flight
+// requests to finish and closes idle connections. If ctx is done before
+// all requests finish, ctx.Err() is returned.
+// Close is idempotent; API calls after Close return ErrClientClosed.
+func (c *Client) Close(ctx context.Context) error {
+	c.mu.Lock()
+	c.closed = true
+	c.mu.Unlock()
+
+	done := make(chan struct{})
+	go func() {
+		c.inflight.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+
+	c.HTTP.CloseIdleConnections()
+	return nil
+}
+
+// requestContext returns ctx with the request timeout applied, the default timeout of the client
+// unless overridden with ContextWithRequestTimeout. A sooner deadline of ctx is kept.
+func (c *Client) requestContext(ctx context.Context) (context.Context, context.CancelFunc) {
+	timeout := c.timeout
+	if t, ok := ctx.Value(requestTimeoutKey{}).(time.Duration); ok {
+		timeout = t
+	}
+	if timeout <= 0 {
+		return ctx, func() {}
+	}
+	return context.WithTimeout(ctx, timeout)
+}
+
+// beginRequest registers an in-flight request. It returns false if the client is closed.
+func (c *Client) beginRequest() bool {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	if c.closed {
+		return false
+	}
+	c.inflight.Add(1)
+	return true
+}
+
 // CreateToken creates a new token for user with optional expire time.
 // Zero time is assumed to be no expire.
+// If the client was created with WithTokenCache, previously signed tokens are reused.
 func (c *Client) CreateToken(userID string, expire time.Time, issuedAt ...time.Time) (string, error) {
 	if userID == "" {
 		return "", errors.New("user ID is empty")
 	}
 
+	key := tokenCacheKey{userID: userID}
 	claims := jwt.MapClaims{
 		"user_id": userID,
 	}
 	if !expire.IsZero() {
-		claims["exp"] = expire.Unix()
+		key.expire = expire.Unix()
+		claims["exp"] = key.expire
 	}
 	if len(issuedAt) > 0 && !issuedAt[0].IsZero() {
-		claims["iat"] = issuedAt[0].Unix()
+		key.issuedAt = issuedAt[0].Unix()
+		claims["iat"] = key.issuedAt
 	}
 
-	return c.createToken(claims)
+	if c.tokenCache == nil {
+		return c.createToken(claims)
+	}
+
+	now := time.Now()
+	if token, ok := c.tokenCache.get(key, now); ok {
+		return token, nil
+	}
+
+	token, err := c.createToken(claims)
+	if err != nil {
+		return "", err
+	}
+	c.tokenCache.put(key, token, now)
+	return token, nil
 }
 
 func (c *Client) createToken(claims jwt.Claims) (string, error) {
 	return jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString(c.apiSecret)
 }
 
+// GenerateConnectionID returns a new random connection ID which can be used to
+// reference a persistent connection in watch and presence calls.
+// The ID is a lowercase, hyphenated version 4 UUID,
+// e.g. 1d5e3b3c-6c5e-4b8e-9a0f-3d2c1b0a9f8e.
+func GenerateConnectionID() string {
+	var b [16]byte
+	if _, err := rand.Read(b[:]); err != nil {
+		panic(err) // crypto/rand never fails on supported platforms
+	}
+
+	b[6] = (b[6] & 0x0f) | 0x40 // version 4
+	b[8] = (b[8] & 0x3f) | 0x80 // variant 10
+
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:16])
+}
+
 // VerifyWebhook validates if hmac signature is correct for message body.
 func (c *Client) VerifyWebhook(body, signature []byte) (valid bool) {
 	mac := hmac.New(crypto.SHA256.New, c.apiSecret)
@@ -203,6 +375,14 @@ func (c *Client) sendFile(ctx context.Context, link string, opts SendFileRequest
 		return nil, errors.New("user is nil")
 	}
 
+	if !c.beginRequest() {
+		return nil, ErrClientClosed
+	}
+	defer c.inflight.Done()
+
+	ctx, cancel := c.requestContext(ctx)
+	defer cancel()
+
 	tmpfile, err := ioutil.TempFile("", opts.FileName)
 	if err != nil {
 		return nil, err
@@ -4,6 +4,8 @@ import (
 	"context"
 	"net/http"
 	"net/url"
+	"regexp"
+	"sync"
 	"testing"
 	"time"
 
@@ -92,3 +94,155 @@ func TestClient_CreateToken(t *testing.T) {
 		})
 	}
 }
+
+func TestGenerateConnectionID(t *testing.T) {
+	uuidV4 := regexp.MustCompile(`^[0-9a-f]{8}-[0-9a-f]{4}-4[0-9a-f]{3}-[89ab][0-9a-f]{3}-[0-9a-f]{12}$`)
+
+	seen := make(map[string]bool)
+	for i := 0; i < 100; i++ {
+		id := GenerateConnectionID()
+		require.Regexp(t, uuidV4, id)
+		require.False(t, seen[id], "connection ID must be unique")
+		seen[id] = true
+	}
+}
+
+func TestClient_Close(t *testing.T) {
+	c := initClient(t)
+	ctx := context.Background()
+
+	_, err := c.GetAppSettings(ctx)
+	require.NoError(t, err)
+
+	require.NoError(t, c.Close(ctx))
+	require.NoError(t, c.Close(ctx), "close is idempotent")
+
+	_, err = c.GetAppSettings(ctx)
+	require.ErrorIs(t, err, ErrClientClosed)
+}
+
+func TestClient_CreateToken_Cache(t *testing.T) {
+	c, err := NewClient("key", "secret", WithTokenCache())
+	require.NoError(t, err)
+
+	expire := time.Now().Add(time.Hour)
+	token, err := c.CreateToken("tommaso", expire)
+	require.NoError(t, err)
+	require.Len(t, c.tokenCache.tokens, 1)
+
+	cached, err := c.CreateToken("tommaso", expire)
+	require.NoError(t, err)
+	require.Equal(t, token, cached)
+	require.Len(t, c.tokenCache.tokens, 1)
+
+	_, err = c.CreateToken("tommaso", expire.Add(time.Hour))
+	require.NoError(t, err)
+	require.Len(t, c.tokenCache.tokens, 2)
+
+	t.Run("expired tokens are evicted", func(t *testing.T) {
+		key := tokenCacheKey{userID: "tommaso", expire: expire.Unix()}
+		_, ok := c.tokenCache.get(key, expire.Add(-time.Second))
+		require.True(t, ok)
+
+		_, ok = c.tokenCache.get(key, expire)
+		require.False(t, ok)
+		require.Len(t, c.tokenCache.tokens, 1)
+
+		c.tokenCache.put(tokenCacheKey{userID: "other"}, "token", expire.Add(2*time.Hour))
+		require.Len(t, c.tokenCache.tokens, 1, "sweep removes expired tokens")
+	})
+
+	t.Run("least recently used tokens are evicted", func(t *testing.T) {
+		tc := newTokenCache(2)
+		now := time.Now()
+		a, b, d := tokenCacheKey{userID: "a"}, tokenCacheKey{userID: "b"}, tokenCacheKey{userID: "d"}
+		tc.put(a, "a", now)
+		tc.put(b, "b", now)
+
+		_, ok := tc.get(a, now)
+		require.True(t, ok)
+
+		tc.put(d, "d", now)
+		require.Len(t, tc.tokens, 2)
+		_, ok = tc.get(b, now)
+		require.False(t, ok, "b is the least recently used")
+		_, ok = tc.get(a, now)
+		require.True(t, ok)
+	})
+
+	t.Run("concurrent access", func(t *testing.T) {
+		var wg sync.WaitGroup
+		for i := 0; i < 10; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				got, err := c.CreateToken("concurrent", expire)
+				require.NoError(t, err)
+				require.NotEmpty(t, got)
+			}()
+		}
+		wg.Wait()
+	})
+}
+
+func TestClient_RequestContext(t *testing.T) {
+	c, err := NewClient("key", "secret", WithTimeout(time.Second))
+	require.NoError(t, err)
+	require.Zero(t, c.HTTP.Timeout)
+
+	t.Run("default timeout", func(t *testing.T) {
+		ctx, cancel := c.requestContext(context.Background())
+		defer cancel()
+
+		deadline, ok := ctx.Deadline()
+		require.True(t, ok)
+		require.WithinDuration(t, time.Now().Add(time.Second), deadline, 100*time.Millisecond)
+	})
+
+	t.Run("sooner deadline applies", func(t *testing.T) {
+		want := time.Now().Add(100 * time.Millisecond)
+		parent, cancelParent := context.WithDeadline(context.Background(), want)
+		defer cancelParent()
+
+		ctx, cancel := c.requestContext(parent)
+		defer cancel()
+
+		deadline, ok := ctx.Deadline()
+		require.True(t, ok)
+		require.Equal(t, want, deadline, "context deadline is sooner")
+
+		parent, cancelParent = context.WithTimeout(context.Background(), time.Minute)
+		defer cancelParent()
+
+		ctx, cancel = c.requestContext(parent)
+		defer cancel()
+
+		deadline, ok = ctx.Deadline()
+		require.True(t, ok)
+		require.WithinDuration(t, time.Now().Add(time.Second), deadline, 100*time.Millisecond, "default timeout is sooner")
+	})
+
+	t.Run("request timeout override", func(t *testing.T) {
+		ctx, cancel := c.requestContext(ContextWithRequestTimeout(context.Background(), time.Minute))
+		defer cancel()
+
+		deadline, ok := ctx.Deadline()
+		require.True(t, ok)
+		require.WithinDuration(t, time.Now().Add(time.Minute), deadline, 100*time.Millisecond)
+
+		ctx, cancel = c.requestContext(ContextWithRequestTimeout(context.Background(), 0))
+		defer cancel()
+
+		_, ok = ctx.Deadline()
+		require.False(t, ok)
+	})
+
+	t.Run("no timeout", func(t *testing.T) {
+		WithTimeout(0)(c)
+		ctx, cancel := c.requestContext(context.Background())
+		defer cancel()
+
+		_, ok := ctx.Deadline()
+		require.False(t, ok)
+	})
+}
@@ -12,15 +12,20 @@ const (
 	PushProviderFirebase = PushProviderType("firebase")
 	PushProviderXiaomi   = PushProviderType("xiaomi")
 	PushProviderHuawei   = PushProviderType("huawei")
+
+	// PushProviderAPNSVoIP registers an APNs VoIP token, e.g. for incoming call pushes.
+	// It is a shorthand for an APNs device with VoIP set, which is how such devices are returned.
+	PushProviderAPNSVoIP = PushProviderType("apn_voip")
 )
 
 type PushProviderType = string
 
 type Device struct {
-	ID               string           `json:"id"`                 // The device ID.
-	UserID           string           `json:"user_id"`            // The user ID for this device.
-	PushProvider     PushProviderType `json:"push_provider"`      // The push provider for this device. One of constants PushProvider*
-	PushProviderName string           `json:"push_provider_name"` // The push provider name for this device.
+	ID               string           `json:"id"`                           // The device ID.
+	UserID           string           `json:"user_id"`                      // The user ID for this device.
+	PushProvider     PushProviderType `json:"push_provider"`                // The push provider for this device. One of constants PushProvider*
+	PushProviderName string           `json:"push_provider_name,omitempty"` // The name of the push provider for this device, if the app has multiple providers of the same type.
+	VoIP             bool             `json:"voip_token,omitempty"`         // Whether the device ID is an APNs VoIP token.
 }
 
 type DevicesResponse struct {
@@ -56,6 +61,13 @@ func (c *Client) AddDevice(ctx context.Context, device *Device) (*Response, erro
 		return nil, errors.New("device push provider is empty")
 	}
 
+	if device.PushProvider == PushProviderAPNSVoIP {
+		d := *device
+		d.PushProvider = PushProviderAPNS
+		d.VoIP = true
+		device = &d
+	}
+
 	var resp Response
 	err := c.makeRequest(ctx, http.MethodPost, "devices", nil, device, &resp)
 	return &resp, err
@@ -31,6 +31,58 @@ func TestClient_Devices(t *testing.T) {
 	}
 }
 
+func TestClient_Devices_PushProviderName(t *testing.T) {
+	c := initClient(t)
+	ctx := context.Background()
+	user := randomUser(t, c)
+
+	provider := &PushProvider{
+		Type:              PushProviderXiaomi,
+		Name:              randomString(10),
+		XiaomiPackageName: "io.getstream.chat",
+		XiaomiAppSecret:   randomString(10),
+	}
+	_, err := c.UpsertPushProvider(ctx, provider)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_, _ = c.DeletePushProvider(ctx, provider.Type, provider.Name)
+	})
+
+	dev := &Device{UserID: user.ID, ID: randomString(12), PushProvider: provider.Type, PushProviderName: provider.Name}
+	_, err = c.AddDevice(ctx, dev)
+	require.NoError(t, err, "add device")
+	t.Cleanup(func() {
+		_, _ = c.DeleteDevice(ctx, user.ID, dev.ID)
+	})
+
+	resp, err := c.GetDevices(ctx, user.ID)
+	require.NoError(t, err, "get devices")
+	require.Len(t, resp.Devices, 1)
+	assert.Equal(t, provider.Name, resp.Devices[0].PushProviderName)
+}
+
+func TestClient_Devices_VoIP(t *testing.T) {
+	c := initClient(t)
+	ctx := context.Background()
+	user := randomUser(t, c)
+
+	regular := &Device{UserID: user.ID, ID: randomString(12), PushProvider: PushProviderAPNS}
+	voip := &Device{UserID: user.ID, ID: randomString(12), PushProvider: PushProviderAPNSVoIP}
+	for _, dev := range []*Device{regular, voip} {
+		_, err := c.AddDevice(ctx, dev)
+		require.NoError(t, err, "add device")
+	}
+	assert.Equal(t, PushProviderAPNSVoIP, voip.PushProvider, "device is not modified")
+
+	resp, err := c.GetDevices(ctx, user.ID)
+	require.NoError(t, err, "get devices")
+	require.Len(t, resp.Devices, 2)
+	for _, dev := range resp.Devices {
+		assert.Equal(t, PushProviderAPNS, dev.PushProvider)
+		assert.Equal(t, dev.ID == voip.ID, dev.VoIP)
+	}
+}
+
 func deviceIDExists(dev []*Device, id string) bool {
 	for _, d := range dev {
 		if d.ID == id {
@@ -0,0 +1,131 @@
+package stream_chat
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"path"
+	"time"
+)
+
+// DraftMessage is the content of an unsent message.
+type DraftMessage struct {
+	ID              string                 `json:"id,omitempty"`
+	Text            string                 `json:"text"`
+	Attachments     []*Attachment          `json:"attachments,omitempty"`
+	MentionedUsers  []string               `json:"mentioned_users,omitempty"`
+	ParentID        string                 `json:"parent_id,omitempty"` // set for a draft reply in a thread
+	QuotedMessageID string                 `json:"quoted_message_id,omitempty"`
+	ShowInChannel   bool                   `json:"show_in_channel,omitempty"`
+	Silent          bool                   `json:"silent,omitempty"`
+	Custom          map[string]interface{} `json:"custom,omitempty"`
+}
+
+// Draft is the draft of a user in a channel or a thread. A user has at most one draft
+// per channel and one per thread.
+type Draft struct {
+	ChannelCID string        `json:"channel_cid"`
+	ParentID   string        `json:"parent_id,omitempty"`
+	Message    *DraftMessage `json:"message"`
+
+	Channel       *Channel `json:"channel,omitempty"`
+	ParentMessage *Message `json:"parent_message,omitempty"`
+	QuotedMessage *Message `json:"quoted_message,omitempty"`
+
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type DraftResponse struct {
+	Draft *Draft `json:"draft"`
+	Response
+}
+
+// CreateDraft saves the draft of the user with given userID in the channel, replacing the
+// previous one. Set draft.ParentID to save the draft of a thread reply.
+func (ch *Channel) CreateDraft(ctx context.Context, draft *DraftMessage, userID string) (*DraftResponse, error) {
+	switch {
+	case draft == nil:
+		return nil, errors.New("draft is nil")
+	case userID == "":
+		return nil, errors.New("user ID must be not empty")
+	}
+
+	p := path.Join("channels", url.PathEscape(ch.Type), url.PathEscape(ch.ID), "draft")
+
+	data := map[string]interface{}{
+		"message": draft,
+		"user_id": userID,
+	}
+
+	var resp DraftResponse
+	err := ch.client.makeRequest(ctx, http.MethodPost, p, nil, data, &resp)
+	return &resp, err
+}
+
+// GetDraft returns the draft of the user with given userID in the channel,
+// or in the thread of the message with given parentID if it is not empty.
+func (ch *Channel) GetDraft(ctx context.Context, userID, parentID string) (*DraftResponse, error) {
+	if userID == "" {
+		return nil, errors.New("user ID must be not empty")
+	}
+
+	p := path.Join("channels", url.PathEscape(ch.Type), url.PathEscape(ch.ID), "draft")
+
+	var resp DraftResponse
+	err := ch.client.makeRequest(ctx, http.MethodGet, p, draftParams(userID, parentID), nil, &resp)
+	return &resp, err
+}
+
+// DeleteDraft deletes the draft of the user with given userID in the channel,
+// or in the thread of the message with given parentID if it is not empty.
+func (ch *Channel) DeleteDraft(ctx context.Context, userID, parentID string) (*Response, error) {
+	if userID == "" {
+		return nil, errors.New("user ID must be not empty")
+	}
+
+	p := path.Join("channels", url.PathEscape(ch.Type), url.PathEscape(ch.ID), "draft")
+
+	var resp Response
+	err := ch.client.makeRequest(ctx, http.MethodDelete, p, draftParams(userID, parentID), nil, &resp)
+	return &resp, err
+}
+
+func draftParams(userID, parentID string) url.Values {
+	params := url.Values{}
+	params.Set("user_id", userID)
+	if parentID != "" {
+		params.Set("parent_id", parentID)
+	}
+	return params
+}
+
+type QueryDraftsRequest struct {
+	UserID string                 `json:"user_id"`
+	Filter map[string]interface{} `json:"filter,omitempty"`
+	Sort   []*SortOption          `json:"sort,omitempty"`
+	Limit  int                    `json:"limit,omitempty"`
+	Next   string                 `json:"next,omitempty"`
+	Prev   string                 `json:"prev,omitempty"`
+}
+
+type QueryDraftsResponse struct {
+	Drafts []*Draft `json:"drafts"`
+	Next   string   `json:"next,omitempty"`
+	Prev   string   `json:"prev,omitempty"`
+	Response
+}
+
+// QueryDrafts returns the drafts of a user across channels matching the given filter.
+func (c *Client) QueryDrafts(ctx context.Context, req *QueryDraftsRequest) (*QueryDraftsResponse, error) {
+	switch {
+	case req == nil:
+		return nil, errors.New("request is nil")
+	case req.UserID == "":
+		return nil, errors.New("user ID must be not empty")
+	}
+
+	var resp QueryDraftsResponse
+	err := c.makeRequest(ctx, http.MethodPost, "drafts/query", nil, req, &resp)
+	return &resp, err
+}
@@ -0,0 +1,43 @@
+package stream_chat
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestChannel_Drafts(t *testing.T) {
+	c := initClient(t)
+	ctx := context.Background()
+	user := randomUser(t, c)
+	ch := initChannel(t, c, user.ID)
+
+	parent, err := ch.SendMessage(ctx, &Message{Text: "parent"}, user.ID)
+	require.NoError(t, err)
+
+	resp, err := ch.CreateDraft(ctx, &DraftMessage{Text: "channel draft"}, user.ID)
+	require.NoError(t, err)
+	require.Equal(t, "channel draft", resp.Draft.Message.Text)
+
+	_, err = ch.CreateDraft(ctx, &DraftMessage{Text: "thread draft", ParentID: parent.Message.ID}, user.ID)
+	require.NoError(t, err)
+
+	resp, err = ch.GetDraft(ctx, user.ID, parent.Message.ID)
+	require.NoError(t, err)
+	require.Equal(t, "thread draft", resp.Draft.Message.Text)
+	require.Equal(t, parent.Message.ID, resp.Draft.ParentID)
+
+	query, err := c.QueryDrafts(ctx, &QueryDraftsRequest{
+		UserID: user.ID,
+		Filter: map[string]interface{}{"channel_cid": ch.CID},
+	})
+	require.NoError(t, err)
+	require.Len(t, query.Drafts, 2)
+
+	_, err = ch.DeleteDraft(ctx, user.ID, "")
+	require.NoError(t, err)
+
+	_, err = ch.GetDraft(ctx, user.ID, "")
+	require.Error(t, err, "draft is deleted")
+}
@@ -4,9 +4,11 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"net/http"
 	"net/url"
 	"path"
+	"sync"
 	"time"
 )
 
@@ -45,7 +47,7 @@ const (
 	// EventChannelTruncated is fired when a channel is truncated.
 	EventChannelTruncated EventType = "channel.truncated"
 
-	// EventHealthCheck is fired when a user is updated.
+	// EventHealthCheck is sent periodically to check the health of a connection or webhook.
 	EventHealthCheck EventType = "health.check"
 
 	// EventNotificationNewMessage and family are fired when a notification is
@@ -70,12 +72,25 @@ const (
 	EventUserWatchingStart   EventType = "user.watching.start"
 	EventUserWatchingStop    EventType = "user.watching.stop"
 	EventUserUpdated         EventType = "user.updated"
+
+	// EventUserBanned is fired when a user is banned, either globally or from a channel.
+	EventUserBanned EventType = "user.banned"
+	// EventUserUnbanned is fired when a user ban is removed.
+	EventUserUnbanned EventType = "user.unbanned"
+	// EventUserDeactivated is fired when a user is deactivated.
+	EventUserDeactivated EventType = "user.deactivated"
+	// EventUserReactivated is fired when a deactivated user is reactivated.
+	EventUserReactivated EventType = "user.reactivated"
 )
 
 // Event is received from a webhook, or sent with the SendEvent function.
 type Event struct {
 	CID          string           `json:"cid,omitempty"` // Channel ID
 	Type         EventType        `json:"type"`          // Event type, one of Event* constants
+	ChannelID    string           `json:"channel_id,omitempty"`
+	ChannelType  string           `json:"channel_type,omitempty"`
+	Team         string           `json:"team,omitempty"`
+	ParentID     string           `json:"parent_id,omitempty"` // thread the event is scoped to, e.g. thread typing indicators
 	Message      *Message         `json:"message,omitempty"`
 	Reaction     *Reaction        `json:"reaction,omitempty"`
 	Channel      *Channel         `json:"channel,omitempty"`
@@ -86,6 +101,16 @@ type Event struct {
 	OwnUser      *User            `json:"me,omitempty"`
 	WatcherCount int              `json:"watcher_count,omitempty"`
 
+	// CreatedBy, Reason, Expiration and Shadow are set on moderation events
+	// such as user.banned and user.deactivated.
+	CreatedBy  *User      `json:"created_by,omitempty"`
+	Reason     string     `json:"reason,omitempty"`
+	Expiration *time.Time `json:"expiration,omitempty"`
+	Shadow     bool       `json:"shadow,omitempty"`
+
+	// ChannelPartial holds the channel fields set by a partial update on channel.updated, if present.
+	ChannelPartial map[string]interface{} `json:"channel_partial,omitempty"`
+
 	ExtraData map[string]interface{} `json:"-"`
 
 	CreatedAt time.Time `json:"created_at,omitempty"`
@@ -112,6 +137,36 @@ func (e Event) MarshalJSON() ([]byte, error) {
 	return addToMapAndMarshal(e.ExtraData, eventForJSON(e))
 }
 
+// IsHealthCheck reports whether the event is a health check, which
+// webhook receivers usually just acknowledge.
+func (e *Event) IsHealthCheck() bool {
+	return e != nil && e.Type == EventHealthCheck
+}
+
+// EventHandler handles an event of a specific type.
+type EventHandler func(e *Event) error
+
+// EventHandlers maps event types to their handlers, e.g.
+//
+//	handlers := EventHandlers{
+//		EventMessageNew: func(e *Event) error { return notify(e.Message) },
+//	}
+type EventHandlers map[EventType]EventHandler
+
+// DispatchEvent calls the handler registered for the type of the event and
+// returns its error. Events without a handler are ignored.
+func DispatchEvent(e *Event, handlers EventHandlers) error {
+	if e == nil {
+		return errors.New("event is nil")
+	}
+
+	handler := handlers[e.Type]
+	if handler == nil {
+		return nil
+	}
+	return handler(e)
+}
+
 // SendEvent sends an event on this channel.
 func (ch *Channel) SendEvent(ctx context.Context, event *Event, userID string) (*Response, error) {
 	if event == nil {
@@ -133,6 +188,74 @@ func (ch *Channel) SendEvent(ctx context.Context, event *Event, userID string) (
 	return &resp, err
 }
 
+// maxConcurrentEvents is the number of events SendEvents sends in parallel.
+const maxConcurrentEvents = 5
+
+// SendEventsError is returned by SendEvents when some of the events could not be sent.
+type SendEventsError struct {
+	// Errors maps the index of each failed event to its error.
+	Errors map[int]error
+}
+
+func (e *SendEventsError) Error() string {
+	first := -1
+	for i := range e.Errors {
+		if first == -1 || i < first {
+			first = i
+		}
+	}
+	return fmt.Sprintf("failed to send %d event(s), event %d: %v", len(e.Errors), first, e.Errors[first])
+}
+
+// SendEvents sends the events on this channel on behalf of userID.
+// There is no batch endpoint, so the events are sent concurrently, a few at a time,
+// and may be delivered in any order. All events are attempted; failures are
+// reported together in a *SendEventsError.
+func (ch *Channel) SendEvents(ctx context.Context, events []*Event, userID string) (*Response, error) {
+	if len(events) == 0 {
+		return nil, errors.New("events are empty")
+	}
+	for _, e := range events {
+		if e == nil {
+			return nil, errors.New("event is nil")
+		}
+	}
+
+	var (
+		mu   sync.Mutex
+		wg   sync.WaitGroup
+		resp Response
+		errs = make(map[int]error)
+		sem  = make(chan struct{}, maxConcurrentEvents)
+	)
+	for i, event := range events {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(i int, event *Event) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+
+			r, err := ch.SendEvent(ctx, event, userID)
+
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				errs[i] = err
+				return
+			}
+			resp = *r
+		}(i, event)
+	}
+	wg.Wait()
+
+	if len(errs) > 0 {
+		return &resp, &SendEventsError{Errors: errs}
+	}
+	return &resp, nil
+}
+
 // UserCustomEvent is a custom event sent to a particular user.
 type UserCustomEvent struct {
 	// Type should be a custom type. Using a built-in event is not supported here.
@@ -186,3 +309,47 @@ func (c *Client) SendUserCustomEvent(ctx context.Context, targetUserID string, e
 	err := c.makeRequest(ctx, http.MethodPost, p, nil, req, &resp)
 	return &resp, err
 }
+
+type SyncOptions struct {
+	// UserID is the user the events are synced for, required for server-side calls.
+	UserID              string `json:"user_id,omitempty"`
+	WithDeletedMessages bool   `json:"with_deleted_messages,omitempty"`
+	// Watch starts watching the channels for the connection with ConnectionID.
+	Watch        bool   `json:"watch,omitempty"`
+	ConnectionID string `json:"connection_id,omitempty"`
+}
+
+type SyncResponse struct {
+	Events           []*Event `json:"events"`
+	InaccessibleCIDs []string `json:"inaccessible_cids,omitempty"`
+	Response
+}
+
+// Sync returns the events that happened in the given channels since lastSyncAt,
+// so a client coming back online can catch up without querying the channels again.
+func (c *Client) Sync(ctx context.Context, channelCIDs []string, lastSyncAt time.Time, opts *SyncOptions) (*SyncResponse, error) {
+	switch {
+	case len(channelCIDs) == 0:
+		return nil, errors.New("channel CIDs are empty")
+	case lastSyncAt.IsZero():
+		return nil, errors.New("last sync time must be set")
+	}
+
+	if opts == nil {
+		opts = &SyncOptions{}
+	}
+
+	req := struct {
+		*SyncOptions
+		ChannelCIDs []string  `json:"channel_cids"`
+		LastSyncAt  time.Time `json:"last_sync_at"`
+	}{
+		SyncOptions: opts,
+		ChannelCIDs: channelCIDs,
+		LastSyncAt:  lastSyncAt,
+	}
+
+	var resp SyncResponse
+	err := c.makeRequest(ctx, http.MethodPost, "sync", nil, req, &resp)
+	return &resp, err
+}
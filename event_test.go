@@ -4,7 +4,9 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -29,6 +31,10 @@ func TestEventSupportsAllFields(t *testing.T) {
 		"channel.updated":  `{"cid":"messaging:fun","type":"channel.updated","channel":{"cid":"messaging:fun","id":"fun","type":"messaging","last_message_at":"2019-04-24T09:49:48.576202Z","created_by":{"id":"57fabaed-446a-40b4-a6ec-e0ac8cad57e3","role":"user","created_at":"2019-04-24T09:49:47.158005Z","updated_at":"2019-04-24T09:49:48.301933Z","last_active":"2019-04-24T09:49:48.497656Z","online":true},"created_at":"2019-04-24T09:49:48.180908Z","updated_at":"2019-04-24T09:49:48.180908Z","frozen":false,"config":{"created_at":"2016-08-18T16:42:30.586808Z","updated_at":"2016-08-18T16:42:30.586808Z","name":"messaging","typing_events":true,"read_events":true,"connect_events":true,"search":true,"reactions":true,"replies":true,"mutes":true,"message_retention":"infinite","max_message_length":5000,"automod":"disabled","commands":["giphy","flag","ban","unban","mute","unmute"]},"awesome":"yes"},"created_at":"2019-04-24T09:49:48.594316Z"}`,
 		"channel.deleted":  `{"cid":"messaging:fun","type":"channel.deleted","channel":{"cid":"messaging:fun","id":"fun","type":"messaging","created_at":"2019-04-24T09:49:48.180908Z","updated_at":"2019-04-24T09:49:48.180908Z","deleted_at":"2019-04-24T09:49:48.626704Z","frozen":false,"config":{"created_at":"2016-08-18T18:42:30.586808+02:00","updated_at":"2016-08-18T18:42:30.586808+02:00","name":"messaging","typing_events":true,"read_events":true,"connect_events":true,"search":true,"reactions":true,"replies":true,"mutes":true,"message_retention":"infinite","max_message_length":5000,"automod":"disabled","commands":["giphy","flag","ban","unban","mute","unmute"]}},"created_at":"2019-04-24T09:49:48.630913Z"}`,
 		"user.updated":     `{"type":"user.updated","user":{"id":"thierry-7b690297-98fa-42dd-b999-a75dd4c7c993","role":"user","online":false,"awesome":true},"created_at":"2019-04-24T12:54:58.956621Z","members":[]}`,
+		"user.banned":      `{"type":"user.banned","cid":"messaging:fun","channel_id":"fun","channel_type":"messaging","user":{"id":"bad-user","role":"user","online":false},"created_by":{"id":"moderator","role":"admin","online":false},"reason":"spam","expiration":"2022-05-12T10:49:48.594316Z","shadow":false,"created_at":"2022-05-12T09:49:48.594316Z"}`,
+		"user.unbanned":    `{"type":"user.unbanned","cid":"messaging:fun","channel_id":"fun","channel_type":"messaging","user":{"id":"bad-user","role":"user","online":false},"created_at":"2022-05-12T09:59:48.594316Z"}`,
+		"user.deactivated": `{"type":"user.deactivated","user":{"id":"bad-user","role":"user","online":false,"deactivated_at":"2022-05-12T09:49:48.594316Z"},"created_by":{"id":"moderator","role":"admin","online":false},"created_at":"2022-05-12T09:49:48.594316Z"}`,
+		"user.reactivated": `{"type":"user.reactivated","user":{"id":"bad-user","role":"user","online":false},"created_by":{"id":"moderator","role":"admin","online":false},"created_at":"2022-05-12T09:59:48.594316Z"}`,
 	}
 
 	for name, blob := range events {
@@ -44,6 +50,118 @@ func TestEventSupportsAllFields(t *testing.T) {
 	}
 }
 
+// TestEventChannelUpdated asserts that the channel.updated webhook decodes
+// the full updated channel, including its custom data.
+//nolint:lll
+func TestEventChannelUpdated(t *testing.T) {
+	// taken from https://getstream.io/chat/docs/webhook_events/ like TestEventSupportsAllFields
+	blob := `{"cid":"messaging:fun","type":"channel.updated","channel":{"cid":"messaging:fun","id":"fun","type":"messaging","last_message_at":"2019-04-24T09:49:48.576202Z","created_by":{"id":"57fabaed-446a-40b4-a6ec-e0ac8cad57e3","role":"user","created_at":"2019-04-24T09:49:47.158005Z","updated_at":"2019-04-24T09:49:48.301933Z","last_active":"2019-04-24T09:49:48.497656Z","online":true},"created_at":"2019-04-24T09:49:48.180908Z","updated_at":"2019-04-24T09:49:48.180908Z","frozen":false,"config":{"created_at":"2016-08-18T16:42:30.586808Z","updated_at":"2016-08-18T16:42:30.586808Z","name":"messaging","typing_events":true,"read_events":true,"connect_events":true,"search":true,"reactions":true,"replies":true,"mutes":true,"message_retention":"infinite","max_message_length":5000,"automod":"disabled","commands":["giphy","flag","ban","unban","mute","unmute"]},"awesome":"yes"},"created_at":"2019-04-24T09:49:48.594316Z"}`
+
+	var ev Event
+	require.NoError(t, json.Unmarshal([]byte(blob), &ev))
+
+	require.Equal(t, EventChannelUpdated, ev.Type)
+	require.Equal(t, "messaging:fun", ev.CID)
+	require.Equal(t, time.Date(2019, 4, 24, 9, 49, 48, 594316000, time.UTC), ev.CreatedAt.UTC())
+	// keys the event doesn't know end up in ExtraData
+	require.Empty(t, ev.ExtraData)
+	require.Nil(t, ev.ChannelPartial)
+
+	require.NotNil(t, ev.Channel)
+	require.Equal(t, "messaging:fun", ev.Channel.CID)
+	require.Equal(t, "fun", ev.Channel.ID)
+	require.Equal(t, "messaging", ev.Channel.Type)
+	require.False(t, ev.Channel.Frozen)
+	require.Equal(t, time.Date(2019, 4, 24, 9, 49, 48, 576202000, time.UTC), ev.Channel.LastMessageAt.UTC())
+	require.Equal(t, "57fabaed-446a-40b4-a6ec-e0ac8cad57e3", ev.Channel.CreatedBy.ID)
+	require.Equal(t, "messaging", ev.Channel.Config.Name)
+	require.True(t, ev.Channel.Config.Replies)
+	require.Equal(t, MessageRetentionForever, ev.Channel.Config.MessageRetention)
+	require.Equal(t, 5000, ev.Channel.Config.MaxMessageLength)
+	require.Equal(t, map[string]interface{}{"awesome": "yes"}, ev.Channel.ExtraData)
+}
+
+// TestEventChannelUpdated_Partial asserts that the channel fields of the event
+// and the fields set by a partial update are decoded.
+//nolint:lll
+func TestEventChannelUpdated_Partial(t *testing.T) {
+	blob := `{"type":"channel.updated","cid":"messaging:fun","channel_id":"fun","channel_type":"messaging","team":"blue","channel":{"cid":"messaging:fun","id":"fun","type":"messaging","team":"blue","frozen":true,"color":"red"},"channel_partial":{"frozen":true,"color":"red"},"created_at":"2022-05-12T09:59:48.594316Z"}`
+
+	var ev Event
+	require.NoError(t, json.Unmarshal([]byte(blob), &ev))
+
+	require.Equal(t, "fun", ev.ChannelID)
+	require.Equal(t, "messaging", ev.ChannelType)
+	require.Equal(t, "blue", ev.Team)
+	require.Equal(t, map[string]interface{}{"frozen": true, "color": "red"}, ev.ChannelPartial)
+	require.Empty(t, ev.ExtraData)
+
+	require.True(t, ev.Channel.Frozen)
+	require.Equal(t, "blue", ev.Channel.Team)
+	require.Equal(t, "red", ev.Channel.ExtraData["color"])
+}
+
+//nolint:lll
+func TestEventUserBanned(t *testing.T) {
+	blob := `{"type":"user.banned","cid":"messaging:fun","channel_id":"fun","channel_type":"messaging","user":{"id":"bad-user","role":"user","online":false},"created_by":{"id":"moderator","role":"admin","online":false},"reason":"spam","expiration":"2022-05-12T10:49:48.594316Z","shadow":true,"created_at":"2022-05-12T09:49:48.594316Z"}`
+
+	var ev Event
+	require.NoError(t, json.Unmarshal([]byte(blob), &ev))
+
+	require.Equal(t, EventUserBanned, ev.Type)
+	require.Equal(t, "bad-user", ev.User.ID)
+	require.Equal(t, "moderator", ev.CreatedBy.ID)
+	require.Equal(t, "spam", ev.Reason)
+	require.True(t, ev.Shadow)
+	require.NotNil(t, ev.Expiration)
+	require.Equal(t, time.Date(2022, 5, 12, 10, 49, 48, 594316000, time.UTC), ev.Expiration.UTC())
+	require.Empty(t, ev.ExtraData)
+}
+
+func TestClient_Sync(t *testing.T) {
+	c := initClient(t)
+	ctx := context.Background()
+	user := randomUser(t, c)
+	ch := initChannel(t, c, user.ID)
+
+	lastSyncAt := time.Now().UTC()
+	time.Sleep(time.Second)
+
+	msg, err := ch.SendMessage(ctx, &Message{Text: "sent while offline"}, user.ID)
+	require.NoError(t, err)
+
+	_, err = c.Sync(ctx, nil, lastSyncAt, nil)
+	require.Error(t, err)
+
+	resp, err := c.Sync(ctx, []string{ch.CID}, lastSyncAt, &SyncOptions{UserID: user.ID})
+	require.NoError(t, err)
+	require.NotEmpty(t, resp.Events)
+
+	var found bool
+	for _, e := range resp.Events {
+		if e.Type == EventMessageNew && e.Message.ID == msg.Message.ID {
+			found = true
+		}
+	}
+	require.True(t, found, "message.new event should be synced")
+}
+
+func TestEventParentID(t *testing.T) {
+	blob := `{"type":"typing.start","cid":"messaging:fun","parent_id":"parent-message","user":{"id":"bob"},"created_at":"2022-05-12T09:49:48.594316Z"}`
+
+	var e Event
+	require.NoError(t, json.Unmarshal([]byte(blob), &e))
+	require.Equal(t, "parent-message", e.ParentID)
+	require.NotContains(t, e.ExtraData, "parent_id")
+
+	b, err := json.Marshal(e)
+	require.NoError(t, err)
+
+	var e2 Event
+	require.NoError(t, json.Unmarshal(b, &e2))
+	require.Equal(t, e, e2)
+}
+
 func TestSendUserCustomEvent(t *testing.T) {
 	c := initClient(t)
 	ctx := context.Background()
@@ -113,3 +231,32 @@ func TestMarshalUnmarshalUserCustomEvent(t *testing.T) {
 
 	require.Equal(t, ev1, ev2)
 }
+
+func TestDispatchEvent(t *testing.T) {
+	var got []EventType
+	handlers := EventHandlers{
+		EventHealthCheck: func(e *Event) error {
+			require.True(t, e.IsHealthCheck())
+			got = append(got, e.Type)
+			return nil
+		},
+		EventMessageNew: func(e *Event) error {
+			require.False(t, e.IsHealthCheck())
+			got = append(got, e.Type)
+			return errors.New("failed")
+		},
+	}
+
+	var e Event
+	require.NoError(t, json.Unmarshal([]byte(`{"type":"health.check","cid":"*"}`), &e))
+	require.NoError(t, DispatchEvent(&e, handlers))
+
+	err := DispatchEvent(&Event{Type: EventMessageNew}, handlers)
+	require.EqualError(t, err, "failed")
+
+	require.NoError(t, DispatchEvent(&Event{Type: "unknown.event"}, handlers))
+	require.NoError(t, DispatchEvent(&Event{Type: EventHealthCheck}, nil))
+	require.Error(t, DispatchEvent(nil, handlers))
+
+	require.Equal(t, []EventType{EventHealthCheck, EventMessageNew}, got)
+}
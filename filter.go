@@ -0,0 +1,107 @@
+package stream_chat
+
+// Filter builds the filter conditions of the query endpoints, e.g. QueryOption.Filter:
+//
+//	Filter{}.Eq("type", "messaging").In("members", []string{"thierry"})
+//
+// Every operator of a field is added to the same condition, so
+// Filter{}.Gt("age", 18).Lt("age", 65) matches ages between 18 and 65.
+// https://getstream.io/chat/docs/#query_syntax
+type Filter map[string]interface{}
+
+func (f Filter) op(field, op string, value interface{}) Filter {
+	if f == nil {
+		f = Filter{}
+	}
+
+	cond, ok := f[field].(map[string]interface{})
+	if !ok {
+		cond = make(map[string]interface{}, 1)
+		f[field] = cond
+	}
+	cond[op] = value
+	return f
+}
+
+func (f Filter) group(op string, filters []Filter) Filter {
+	if f == nil {
+		f = Filter{}
+	}
+
+	conds := make([]map[string]interface{}, 0, len(filters))
+	for _, filter := range filters {
+		conds = append(conds, filter)
+	}
+	f[op] = conds
+	return f
+}
+
+// Eq matches the documents whose field equals value.
+func (f Filter) Eq(field string, value interface{}) Filter {
+	return f.op(field, "$eq", value)
+}
+
+// Ne matches the documents whose field doesn't equal value.
+func (f Filter) Ne(field string, value interface{}) Filter {
+	return f.op(field, "$ne", value)
+}
+
+// Gt matches the documents whose field is greater than value.
+func (f Filter) Gt(field string, value interface{}) Filter {
+	return f.op(field, "$gt", value)
+}
+
+// Gte matches the documents whose field is greater than or equal to value.
+func (f Filter) Gte(field string, value interface{}) Filter {
+	return f.op(field, "$gte", value)
+}
+
+// Lt matches the documents whose field is less than value.
+func (f Filter) Lt(field string, value interface{}) Filter {
+	return f.op(field, "$lt", value)
+}
+
+// Lte matches the documents whose field is less than or equal to value.
+func (f Filter) Lte(field string, value interface{}) Filter {
+	return f.op(field, "$lte", value)
+}
+
+// In matches the documents whose field equals one of values, which must be a slice.
+func (f Filter) In(field string, values interface{}) Filter {
+	return f.op(field, "$in", values)
+}
+
+// Nin matches the documents whose field equals none of values, which must be a slice.
+func (f Filter) Nin(field string, values interface{}) Filter {
+	return f.op(field, "$nin", values)
+}
+
+// Exists matches the documents which have (or, if exists is false, don't have) the field.
+func (f Filter) Exists(field string, exists bool) Filter {
+	return f.op(field, "$exists", exists)
+}
+
+// Contains matches the documents whose array field contains value.
+func (f Filter) Contains(field string, value interface{}) Filter {
+	return f.op(field, "$contains", value)
+}
+
+// Autocomplete matches the documents whose field has a word starting with prefix.
+func (f Filter) Autocomplete(field, prefix string) Filter {
+	return f.op(field, "$autocomplete", prefix)
+}
+
+// And matches the documents which match all of filters.
+func (f Filter) And(filters ...Filter) Filter {
+	return f.group("$and", filters)
+}
+
+// Or matches the documents which match at least one of filters.
+func (f Filter) Or(filters ...Filter) Filter {
+	return f.group("$or", filters)
+}
+
+// Nor matches the documents which match none of filters.
+func (f Filter) Nor(filters ...Filter) Filter {
+	return f.group("$nor", filters)
+}
@@ -0,0 +1,67 @@
+package stream_chat
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFilter(t *testing.T) {
+	type obj = map[string]interface{}
+
+	tests := []struct {
+		name   string
+		filter Filter
+		want   obj
+	}{
+		{"eq", Filter{}.Eq("type", "messaging"), obj{"type": obj{"$eq": "messaging"}}},
+		{"ne", Filter{}.Ne("type", "livestream"), obj{"type": obj{"$ne": "livestream"}}},
+		{"gt", Filter{}.Gt("age", 18), obj{"age": obj{"$gt": 18}}},
+		{"gte", Filter{}.Gte("age", 18), obj{"age": obj{"$gte": 18}}},
+		{"lt", Filter{}.Lt("age", 65), obj{"age": obj{"$lt": 65}}},
+		{"lte", Filter{}.Lte("age", 65), obj{"age": obj{"$lte": 65}}},
+		{"in", Filter{}.In("members", []string{"a", "b"}), obj{"members": obj{"$in": []string{"a", "b"}}}},
+		{"nin", Filter{}.Nin("id", []string{"a"}), obj{"id": obj{"$nin": []string{"a"}}}},
+		{"exists", Filter{}.Exists("team", false), obj{"team": obj{"$exists": false}}},
+		{"contains", Filter{}.Contains("teams", "red"), obj{"teams": obj{"$contains": "red"}}},
+		{"autocomplete", Filter{}.Autocomplete("name", "jo"), obj{"name": obj{"$autocomplete": "jo"}}},
+		{"range", Filter{}.Gt("age", 18).Lt("age", 65), obj{"age": obj{"$gt": 18, "$lt": 65}}},
+		{"nil filter", Filter(nil).Eq("frozen", true), obj{"frozen": obj{"$eq": true}}},
+		{
+			"and",
+			Filter{}.And(Filter{}.Eq("type", "messaging"), Filter{}.In("members", []string{"a"})),
+			obj{"$and": []obj{{"type": obj{"$eq": "messaging"}}, {"members": obj{"$in": []string{"a"}}}}},
+		},
+		{
+			"or",
+			Filter{}.Eq("frozen", false).Or(Filter{}.Eq("type", "team"), Filter{}.Eq("type", "gaming")),
+			obj{"frozen": obj{"$eq": false}, "$or": []obj{{"type": obj{"$eq": "team"}}, {"type": obj{"$eq": "gaming"}}}},
+		},
+		{
+			"nor",
+			Filter{}.Nor(Filter{}.Eq("disabled", true)),
+			obj{"$nor": []obj{{"disabled": obj{"$eq": true}}}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, map[string]interface{}(tt.filter))
+		})
+	}
+}
+
+func TestClient_QueryChannels_Filter(t *testing.T) {
+	c := initClient(t)
+	ctx := context.Background()
+	member := randomUser(t, c)
+	ch := initChannel(t, c, member.ID)
+
+	resp, err := c.QueryChannels(ctx, &QueryOption{
+		Filter: Filter{}.Eq("type", ch.Type).In("members", []string{member.ID}),
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.Channels, 1)
+	require.Equal(t, ch.CID, resp.Channels[0].CID)
+}
@@ -9,6 +9,7 @@ import (
 	"io"
 	"net/http"
 	"net/url"
+	"time"
 )
 
 type Error struct {
@@ -30,8 +31,39 @@ func (e Error) Error() string {
 // All specific response returned to the client should embed this type.
 type Response struct {
 	RateLimitInfo *RateLimitInfo `json:"ratelimit"`
+
+	// Headers contains the HTTP headers of the response.
+	Headers http.Header `json:"-"`
+	// RawBody contains the undecoded response body. It is only populated
+	// when the client was created with WithKeepRawBody, so fields not
+	// modeled by the response types can still be accessed.
+	RawBody []byte `json:"-"`
+}
+
+func (r *Response) setRaw(headers http.Header, body []byte) {
+	r.Headers = headers
+	r.RawBody = body
+}
+
+// rawResponse is implemented by all types embedding Response.
+type rawResponse interface {
+	setRaw(headers http.Header, body []byte)
+}
+
+// RequestInfo describes a completed API request.
+type RequestInfo struct {
+	Method     string
+	Path       string
+	StatusCode int // zero if no response was received
+	Duration   time.Duration
+	Err        error
 }
 
+// RequestHook is called after every API request. It receives the context
+// the request was made with, so values stored in it (e.g. a trace ID)
+// can be used to correlate the request with the caller's own telemetry.
+type RequestHook func(ctx context.Context, info RequestInfo)
+
 func (c *Client) parseResponse(resp *http.Response, result interface{}) error {
 	if resp.Body == nil {
 		return errors.New("http body is nil")
@@ -65,6 +97,19 @@ func (c *Client) parseResponse(resp *http.Response, result interface{}) error {
 		if err != nil {
 			return fmt.Errorf("cannot unmarshal body: %w", err)
 		}
+
+		if c.useJSONNumber {
+			if err := extraDataWithNumbers(result, b); err != nil {
+				return fmt.Errorf("cannot unmarshal body: %w", err)
+			}
+		}
+	}
+
+	if raw, ok := result.(rawResponse); ok {
+		if !c.keepRawBody {
+			b = nil
+		}
+		raw.setRaw(resp.Header, b)
 	}
 
 	return c.addRateLimitInfo(resp.Header, result)
@@ -127,7 +172,35 @@ func (c *Client) setHeaders(r *http.Request) {
 	r.Header.Set("Stream-Auth-Type", "jwt")
 }
 
-func (c *Client) makeRequest(ctx context.Context, method, path string, params url.Values, data, result interface{}) error {
+func (c *Client) makeRequest(ctx context.Context, method, path string, params url.Values, data, result interface{}) (err error) {
+	if !c.beginRequest() {
+		return ErrClientClosed
+	}
+	defer c.inflight.Done()
+
+	ctx, cancel := c.requestContext(ctx)
+	defer cancel()
+
+	var statusCode int
+	if c.tracer != nil {
+		var endSpan func(int, error)
+		ctx, endSpan = c.startSpan(ctx, method, path)
+		defer func() { endSpan(statusCode, err) }()
+	}
+
+	if len(c.requestHooks) > 0 {
+		start := time.Now()
+		defer func() {
+			c.runRequestHooks(ctx, RequestInfo{
+				Method:     method,
+				Path:       path,
+				StatusCode: statusCode,
+				Duration:   time.Since(start),
+				Err:        err,
+			})
+		}()
+	}
+
 	r, err := c.newRequest(ctx, method, path, params, data)
 	if err != nil {
 		return err
@@ -144,10 +217,17 @@ func (c *Client) makeRequest(ctx context.Context, method, path string, params ur
 		}
 		return err
 	}
+	statusCode = resp.StatusCode
 
 	return c.parseResponse(resp, result)
 }
 
+func (c *Client) runRequestHooks(ctx context.Context, info RequestInfo) {
+	for _, hook := range c.requestHooks {
+		hook(ctx, info)
+	}
+}
+
 func (c *Client) addRateLimitInfo(headers http.Header, result interface{}) error {
 	rl := map[string]interface{}{
 		"ratelimit": NewRateLimitFromHeaders(headers),
@@ -55,3 +55,68 @@ func TestContextExceeded(t *testing.T) {
 	require.Error(t, err)
 	require.ErrorIs(t, err, context.DeadlineExceeded)
 }
+
+type traceIDKey struct{}
+
+// TestRequestHook asserts that request hooks receive the caller's context.
+func TestRequestHook(t *testing.T) {
+	var (
+		gotTraceID interface{}
+		gotInfo    RequestInfo
+	)
+	c := initClient(t)
+	WithRequestHook(func(ctx context.Context, info RequestInfo) {
+		gotTraceID = ctx.Value(traceIDKey{})
+		gotInfo = info
+	})(c)
+
+	ctx := context.WithValue(context.Background(), traceIDKey{}, "trace-1")
+	_, err := c.GetAppSettings(ctx)
+	require.NoError(t, err)
+
+	require.Equal(t, "trace-1", gotTraceID)
+	require.Equal(t, http.MethodGet, gotInfo.Method)
+	require.Equal(t, "app", gotInfo.Path)
+	require.Equal(t, http.StatusOK, gotInfo.StatusCode)
+	require.NoError(t, gotInfo.Err)
+}
+
+func TestRequestLogger(t *testing.T) {
+	c := initClient(t)
+	ctx := context.Background()
+
+	WithRequestLogger(nil)(c)
+
+	var (
+		gotMethod, gotPath string
+		gotStatus          int
+		gotErr             error
+	)
+	WithRequestLogger(func(_ context.Context, method, path string, status int, _ time.Duration, err error) {
+		gotMethod, gotPath, gotStatus, gotErr = method, path, status, err
+	})(c)
+
+	_, err := c.GetMessage(ctx, randomString(12))
+	require.Error(t, err)
+
+	require.Equal(t, http.MethodGet, gotMethod)
+	require.Contains(t, gotPath, "messages/")
+	require.Equal(t, http.StatusNotFound, gotStatus)
+	require.Equal(t, err, gotErr)
+}
+
+func TestResponse_RawBodyAndHeaders(t *testing.T) {
+	c := initClient(t)
+	ctx := context.Background()
+
+	resp, err := c.GetAppSettings(ctx)
+	require.NoError(t, err)
+	require.NotEmpty(t, resp.Headers.Get("Content-Type"))
+	require.Nil(t, resp.RawBody)
+
+	WithKeepRawBody()(c)
+
+	resp, err = c.GetAppSettings(ctx)
+	require.NoError(t, err)
+	require.Contains(t, string(resp.RawBody), `"app"`)
+}
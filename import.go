@@ -2,8 +2,10 @@ package stream_chat
 
 import (
 	"context"
+	"errors"
 	"net/http"
 	"net/url"
+	"path"
 	"strconv"
 	"time"
 )
@@ -64,17 +66,30 @@ type ListImportsResponse struct {
 // It is present for internal usage only.
 // This function can, and will, break and/or be removed at any point in time.
 func (c *Client) CreateImportURL(ctx context.Context, filename string) (*CreateImportURLResponse, error) {
+	if filename == "" {
+		return nil, errors.New("filename is empty")
+	}
+
 	var resp CreateImportURLResponse
 	err := c.makeRequest(ctx, http.MethodPost, "import_urls", nil, map[string]string{"filename": filename}, &resp)
 
 	return &resp, err
 }
 
-// CreateImport creates a new import task.
+// CreateImport creates a new import task for the file uploaded to filePath.
+// Imported messages keep their original created_at and don't trigger push notifications or webhooks.
+// Poll the task with GetImport until it completes.
 // Note: Do not use this.
 // It is present for internal usage only.
 // This function can, and will, break and/or be removed at any point in time.
 func (c *Client) CreateImport(ctx context.Context, filePath string, mode ImportMode) (*CreateImportResponse, error) {
+	switch {
+	case filePath == "":
+		return nil, errors.New("file path is empty")
+	case mode != InsertMode && mode != UpsertMode:
+		return nil, errors.New("mode must be insert or upsert")
+	}
+
 	var resp CreateImportResponse
 	err := c.makeRequest(ctx, http.MethodPost, "imports", nil, map[string]string{"path": filePath, "mode": string(mode)}, &resp)
 
@@ -86,8 +101,14 @@ func (c *Client) CreateImport(ctx context.Context, filePath string, mode ImportM
 // It is present for internal usage only.
 // This function can, and will, break and/or be removed at any point in time.
 func (c *Client) GetImport(ctx context.Context, id string) (*GetImportResponse, error) {
+	if id == "" {
+		return nil, errors.New("import ID is empty")
+	}
+
+	p := path.Join("imports", url.PathEscape(id))
+
 	var resp GetImportResponse
-	err := c.makeRequest(ctx, http.MethodGet, "imports/"+id, nil, nil, &resp)
+	err := c.makeRequest(ctx, http.MethodGet, p, nil, nil, &resp)
 
 	return &resp, err
 }
@@ -47,3 +47,20 @@ func TestImportsEndToEnd(t *testing.T) {
 	require.NoError(t, err)
 	require.NotEmpty(t, listResp.ImportTasks)
 }
+
+func TestImports_Validation(t *testing.T) {
+	c := initClient(t)
+	ctx := context.Background()
+
+	_, err := c.CreateImportURL(ctx, "")
+	require.Error(t, err)
+
+	_, err = c.CreateImport(ctx, "", UpsertMode)
+	require.Error(t, err)
+
+	_, err = c.CreateImport(ctx, "path/to/file.json", "replace")
+	require.Error(t, err)
+
+	_, err = c.GetImport(ctx, "")
+	require.Error(t, err)
+}
@@ -1,6 +1,7 @@
 package stream_chat
 
 import (
+	"bytes"
 	"encoding/json"
 	"reflect"
 	"strings"
@@ -14,19 +15,54 @@ func copyMap(m map[string]interface{}) map[string]interface{} {
 	return m2
 }
 
+// removeFromMap removes the keys of the JSON encoded fields of obj from m, so only custom data is left.
 func removeFromMap(m map[string]interface{}, obj interface{}) {
-	t := reflect.TypeOf(obj)
+	for _, name := range jsonFieldNames(reflect.TypeOf(obj)) {
+		delete(m, name)
+	}
+}
+
+// jsonFieldNames returns the keys the fields of struct type t are encoded with,
+// including the fields promoted from embedded structs.
+func jsonFieldNames(t reflect.Type) []string {
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+
+	names := make([]string, 0, t.NumField())
 	for i := 0; i < t.NumField(); i++ {
 		f := t.Field(i)
-		if tag := f.Tag.Get("json"); tag != "" {
-			tag = strings.Split(tag, ",")[0]
-			delete(m, tag)
-		} else {
-			delete(m, f.Name)
+		tag := f.Tag.Get("json")
+		if tag == "-" {
+			continue
 		}
+		name := strings.Split(tag, ",")[0]
+
+		if f.Anonymous && name == "" {
+			ft := f.Type
+			if ft.Kind() == reflect.Ptr {
+				ft = ft.Elem()
+			}
+			if ft.Kind() == reflect.Struct {
+				names = append(names, jsonFieldNames(ft)...)
+				continue
+			}
+		}
+		if f.PkgPath != "" {
+			// unexported fields aren't encoded
+			continue
+		}
+		if name == "" {
+			name = f.Name
+		}
+		names = append(names, name)
 	}
+	return names
 }
 
+// addToMapAndMarshal marshals obj with the custom data of m merged in.
+// The fields emitted for obj take precedence over the keys of m they collide with,
+// keys matching a field omitted because it is empty are kept.
 func addToMapAndMarshal(m map[string]interface{}, obj interface{}) ([]byte, error) {
 	m2 := copyMap(m)
 
@@ -39,3 +75,103 @@ func addToMapAndMarshal(m map[string]interface{}, obj interface{}) ([]byte, erro
 	}
 	return json.Marshal(m2)
 }
+
+var extraDataType = reflect.TypeOf(map[string]interface{}{})
+
+// extraDataWithNumbers replaces the values of the ExtraData maps in result, which was decoded
+// from data, with values decoded with json.Number for numbers.
+// ExtraData is decoded by the UnmarshalJSON methods of its types, which don't share the options
+// of the decoder of the response, so it's patched in a second pass.
+func extraDataWithNumbers(result interface{}, data []byte) error {
+	d := json.NewDecoder(bytes.NewReader(data))
+	d.UseNumber()
+
+	var raw interface{}
+	if err := d.Decode(&raw); err != nil {
+		return err
+	}
+
+	replaceExtraData(reflect.ValueOf(result), raw)
+	return nil
+}
+
+// replaceExtraData walks v and the raw JSON value it was decoded from side by side,
+// and copies the raw values of the ExtraData keys into the ExtraData maps of v.
+func replaceExtraData(v reflect.Value, raw interface{}) {
+	switch v.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if !v.IsNil() {
+			replaceExtraData(v.Elem(), raw)
+		}
+
+	case reflect.Slice, reflect.Array:
+		rawSlice, ok := raw.([]interface{})
+		if !ok {
+			return
+		}
+		for i := 0; i < v.Len() && i < len(rawSlice); i++ {
+			replaceExtraData(v.Index(i), rawSlice[i])
+		}
+
+	case reflect.Map:
+		rawMap, ok := raw.(map[string]interface{})
+		if !ok || v.Type().Key().Kind() != reflect.String || v.Type() == extraDataType {
+			return
+		}
+		iter := v.MapRange()
+		for iter.Next() {
+			replaceExtraData(iter.Value(), rawMap[iter.Key().String()])
+		}
+
+	case reflect.Struct:
+		rawMap, ok := raw.(map[string]interface{})
+		if !ok {
+			return
+		}
+
+		t := v.Type()
+		for i := 0; i < t.NumField(); i++ {
+			f := t.Field(i)
+			if f.Name == "ExtraData" && f.Type == extraDataType {
+				setExtraData(v.Field(i), rawMap)
+				continue
+			}
+
+			tag := f.Tag.Get("json")
+			if tag == "-" {
+				continue
+			}
+			name := strings.Split(tag, ",")[0]
+
+			switch {
+			case f.Anonymous && name == "":
+				replaceExtraData(v.Field(i), raw)
+			case f.PkgPath != "":
+				// unexported fields aren't decoded
+			default:
+				if name == "" {
+					name = f.Name
+				}
+				replaceExtraData(v.Field(i), rawMap[name])
+			}
+		}
+	}
+}
+
+func setExtraData(m reflect.Value, rawMap map[string]interface{}) {
+	if m.IsNil() {
+		return
+	}
+
+	for _, k := range m.MapKeys() {
+		rv, ok := rawMap[k.String()]
+		if !ok {
+			continue
+		}
+		val := reflect.New(extraDataType.Elem()).Elem()
+		if rv != nil {
+			val.Set(reflect.ValueOf(rv))
+		}
+		m.SetMapIndex(k, val)
+	}
+}
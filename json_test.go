@@ -66,4 +66,81 @@ func TestJSON(t *testing.T) {
 
 	var r, r2 Reaction
 	testInvariantJSON(t, &r, &r2)
+
+	var mh, mh2 MessageHistoryEntry
+	testInvariantJSON(t, &mh, &mh2)
+
+	var cm, cm2 ChannelMember
+	testInvariantJSON(t, &cm, &cm2)
+}
+
+func TestJSON_ExtraDataCollision(t *testing.T) {
+	// a custom field colliding with a struct field is kept only while
+	// the field is omitted because it is empty
+	u := User{ID: "id", ExtraData: map[string]interface{}{"name": "custom", "color": "red"}}
+	data, err := json.Marshal(u)
+	require.NoError(t, err)
+	require.JSONEq(t, `{"id":"id","name":"custom","color":"red"}`, string(data))
+
+	u.Name = "name"
+	data, err = json.Marshal(u)
+	require.NoError(t, err)
+	require.JSONEq(t, `{"id":"id","name":"name","color":"red"}`, string(data))
+
+	var u2 User
+	require.NoError(t, json.Unmarshal(data, &u2))
+	require.Equal(t, "name", u2.Name)
+	require.Equal(t, map[string]interface{}{"color": "red"}, u2.ExtraData)
+}
+
+func TestJSON_ExtraDataTime(t *testing.T) {
+	// custom fields named like unexported struct fields are kept
+	// and custom timestamps round trip as RFC3339 strings
+	at := "2022-01-02T03:04:05.123Z"
+	var ch Channel
+	require.NoError(t, json.Unmarshal([]byte(`{"id":"id","client":"web","expires_at":"`+at+`"}`), &ch))
+	require.Equal(t, map[string]interface{}{"client": "web", "expires_at": at}, ch.ExtraData)
+
+	data, err := json.Marshal(ch)
+	require.NoError(t, err)
+
+	var ch2 Channel
+	require.NoError(t, json.Unmarshal(data, &ch2))
+	require.Equal(t, ch.ExtraData, ch2.ExtraData)
+}
+
+func TestJSONFieldNames(t *testing.T) {
+	type embedded struct {
+		Embedded string `json:"embedded"`
+	}
+	type obj struct {
+		*embedded
+		Named    string `json:"named,omitempty"`
+		OnlyOpts string `json:",omitempty"`
+		Untagged string
+		Skipped  string `json:"-"`
+	}
+
+	require.Equal(t, []string{"embedded", "named", "OnlyOpts", "Untagged"}, jsonFieldNames(reflect.TypeOf(obj{})))
+}
+
+func TestExtraDataWithNumbers(t *testing.T) {
+	data := []byte(`{
+		"message": {
+			"id": "msg",
+			"snowflake": 1234567890123456789,
+			"user": {"id": "user", "snowflake": 1234567890123456789},
+			"attachments": [{"type": "image", "size": {"width": 100}}]
+		}
+	}`)
+
+	var resp MessageResponse
+	require.NoError(t, json.Unmarshal(data, &resp))
+	require.IsType(t, float64(0), resp.Message.ExtraData["snowflake"])
+
+	require.NoError(t, extraDataWithNumbers(&resp, data))
+	require.Equal(t, json.Number("1234567890123456789"), resp.Message.ExtraData["snowflake"])
+	require.Equal(t, json.Number("1234567890123456789"), resp.Message.User.ExtraData["snowflake"])
+	require.Equal(t, map[string]interface{}{"width": json.Number("100")}, resp.Message.Attachments[0].ExtraData["size"])
+	require.Equal(t, "msg", resp.Message.ID)
 }
@@ -4,9 +4,12 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"net/http"
 	"net/url"
 	"path"
+	"strconv"
+	"strings"
 	"time"
 )
 
@@ -41,12 +44,19 @@ type Message struct {
 	ShowInChannel      bool    `json:"show_in_channel,omitempty"` // show reply message also in channel
 	ThreadParticipants []*User `json:"thread_participants,omitempty"`
 
-	ReplyCount      int     `json:"reply_count,omitempty"`
-	QuotedMessageID string  `json:"quoted_message_id,omitempty"`
-	MentionedUsers  []*User `json:"mentioned_users"`
+	ReplyCount      int      `json:"reply_count,omitempty"`
+	QuotedMessageID string   `json:"quoted_message_id,omitempty"`
+	QuotedMessage   *Message `json:"quoted_message,omitempty"` // set by the server when QuotedMessageID is set
+	MentionedUsers  []*User  `json:"mentioned_users"`
 
 	Command string `json:"command,omitempty"`
 
+	// RestrictedVisibility lists the IDs of the only users who can see the message.
+	RestrictedVisibility []string `json:"restricted_visibility,omitempty"`
+
+	PollID string `json:"poll_id,omitempty"` // id of a poll attached to the message
+	Poll   *Poll  `json:"poll,omitempty"`
+
 	Shadowed   bool       `json:"shadowed,omitempty"`
 	Pinned     bool       `json:"pinned,omitempty"`
 	PinnedAt   *time.Time `json:"pinned_at,omitempty"`
@@ -91,6 +101,7 @@ func (m *Message) toRequest() messageRequest {
 	var req messageRequest
 
 	req.Message = messageRequestMessage{
+		ID:              m.ID,
 		Text:            m.Text,
 		Attachments:     m.Attachments,
 		User:            messageRequestUser{ID: m.User.ID},
@@ -100,6 +111,9 @@ func (m *Message) toRequest() messageRequest {
 		ShowInChannel:   m.ShowInChannel,
 		Silent:          m.Silent,
 		QuotedMessageID: m.QuotedMessageID,
+		PollID:          m.PollID,
+
+		RestrictedVisibility: m.RestrictedVisibility,
 	}
 
 	if len(m.MentionedUsers) > 0 {
@@ -118,9 +132,12 @@ type messageRequest struct {
 	SkipEnrichURL          bool                  `json:"skip_enrich_url,omitempty"`
 	IsPendingMessage       bool                  `json:"is_pending_message,omitempty"`
 	PendingMessageMetadata map[string]string     `json:"pending_message_metadata,omitempty"`
+	KeepChannelHidden      bool                  `json:"keep_channel_hidden,omitempty"`
+	ForceModeration        bool                  `json:"force_moderation,omitempty"`
 }
 
 type messageRequestMessage struct {
+	ID              string             `json:"id,omitempty"`
 	Text            string             `json:"text"`
 	Attachments     []*Attachment      `json:"attachments"`
 	User            messageRequestUser `json:"user"`
@@ -130,6 +147,11 @@ type messageRequestMessage struct {
 	Silent          bool               `json:"silent"`
 	QuotedMessageID string             `json:"quoted_message_id"`
 	Pinned          bool               `json:"pinned"`
+	PollID          string             `json:"poll_id,omitempty"`
+
+	RestrictedVisibility []string `json:"restricted_visibility,omitempty"`
+
+	CreatedAt *time.Time `json:"created_at,omitempty"`
 
 	ExtraData map[string]interface{} `json:"-"`
 }
@@ -171,6 +193,12 @@ type Attachment struct {
 	ThumbURL    string `json:"thumb_url,omitempty"`
 	AssetURL    string `json:"asset_url,omitempty"`
 	OGScrapeURL string `json:"og_scrape_url,omitempty"`
+	Fallback    string `json:"fallback,omitempty"`
+
+	MimeType string `json:"mime_type,omitempty"`
+	FileSize int64  `json:"file_size,omitempty"`
+
+	Actions []*AttachmentAction `json:"actions,omitempty"`
 
 	ExtraData map[string]interface{} `json:"-"`
 }
@@ -223,6 +251,22 @@ func MessagePending(r *messageRequest) {
 	}
 }
 
+// MessageKeepChannelHidden is a flag that keeps the channel hidden for the members who hid it.
+// By default, a new message makes a hidden channel visible again.
+func MessageKeepChannelHidden(r *messageRequest) {
+	if r != nil {
+		r.KeepChannelHidden = true
+	}
+}
+
+// MessageForceModeration is a flag that runs the message through the moderation pipeline,
+// even if its sender would otherwise skip it, e.g. because it is sent server-side.
+func MessageForceModeration(r *messageRequest) {
+	if r != nil {
+		r.ForceModeration = true
+	}
+}
+
 // MessagePendingMessageMetadata saves metadata to the pending message
 func MessagePendingMessageMetadata(metadata map[string]string) SendMessageOption {
 	return func(r *messageRequest) {
@@ -232,32 +276,73 @@ func MessagePendingMessageMetadata(metadata map[string]string) SendMessageOption
 	}
 }
 
+// MessageResponse is returned by the API calls which return a single message.
 type MessageResponse struct {
-	Message                *Message          `json:"message"`
+	Message *Message `json:"message"`
+	// PendingMessageMetadata is the metadata saved with a pending message, see MessagePendingMessageMetadata.
 	PendingMessageMetadata map[string]string `json:"pending_message_metadata,omitempty"`
+	// Duration is the time the server took to handle the request, e.g. "12.34ms".
+	Duration string `json:"duration,omitempty"`
 	Response
 }
 
+// MessageError is returned when the server rejects a message without an HTTP error,
+// by responding with a message of type MessageTypeError, e.g. when it is blocked by moderation.
+type MessageError struct {
+	Message *Message
+}
+
+func (e *MessageError) Error() string {
+	return "message rejected: " + e.Message.Text
+}
+
+// checkMessageType returns a *MessageError if the message in the response is an error message.
+func (r *MessageResponse) checkMessageType() error {
+	if r.Message != nil && r.Message.Type == MessageTypeError {
+		return &MessageError{Message: r.Message}
+	}
+	return nil
+}
+
+// maxMessageIDLength is the maximum length of a client-generated message ID.
+const maxMessageIDLength = 255
+
 // SendMessage sends a message to the channel. Returns full message details from server.
+// If message.ID is set, the message is created with that ID, which lets the caller match
+// an optimistically rendered message with the server response and the webhook echo.
+// Sending a message with an ID which is already used fails with an Error, no message is overwritten.
+// If message.CreatedAt is set, the message is created with that timestamp instead of the current time,
+// e.g. to backfill messages from another system. Use the import for larger migrations.
+// If the server rejects the message with an error message, the response is returned
+// together with a *MessageError.
+// The behavior can be changed with options, e.g. MessageSkipPush, MessageSkipEnrichURL,
+// MessagePending and MessageForceModeration.
 func (ch *Channel) SendMessage(ctx context.Context, message *Message, userID string, options ...SendMessageOption) (*MessageResponse, error) {
 	switch {
 	case message == nil:
 		return nil, errors.New("message is nil")
 	case userID == "":
 		return nil, errors.New("user ID must be not empty")
+	case len(message.ID) > maxMessageIDLength:
+		return nil, fmt.Errorf("message ID must be at most %d characters", maxMessageIDLength)
+	case strings.ContainsAny(message.ID, " \t\n"):
+		return nil, errors.New("message ID must not contain whitespace")
 	}
 
 	message.User = &User{ID: userID}
 	p := path.Join("channels", url.PathEscape(ch.Type), url.PathEscape(ch.ID), "message")
 
 	req := message.toRequest()
+	req.Message.CreatedAt = message.CreatedAt
 	for _, op := range options {
 		op(&req)
 	}
 
 	var resp MessageResponse
-	err := ch.client.makeRequest(ctx, http.MethodPost, p, nil, req, &resp)
-	return &resp, err
+	if err := ch.client.makeRequest(ctx, http.MethodPost, p, nil, req, &resp); err != nil {
+		return &resp, err
+	}
+	return &resp, resp.checkMessageType()
 }
 
 // MarkAllRead marks all messages as read for userID.
@@ -277,16 +362,68 @@ func (c *Client) MarkAllRead(ctx context.Context, userID string) (*Response, err
 	return &resp, err
 }
 
+type MarkAllReadResponse struct {
+	// ChannelsMarked is the approximate number of channels which had unread messages,
+	// see MarkAllReadWithCounts.
+	ChannelsMarked int
+	// UnreadCounts are the unread counts of the user after marking, e.g. the unread threads.
+	UnreadCounts
+	Response
+}
+
+// MarkAllReadWithCounts marks all messages as read for userID, like MarkAllRead,
+// and returns the number of channels affected and the resulting unread counts,
+// so badges can be refreshed without another query.
+// The counts are fetched with GetUnreadCount before and after marking.
+//
+// ChannelsMarked is approximate: the three requests aren't atomic, so messages received
+// in between are not accounted for, and GetUnreadCount only lists a limited number of
+// channels, so it is at most that limit for users with more unread channels.
+func (c *Client) MarkAllReadWithCounts(ctx context.Context, userID string) (*MarkAllReadResponse, error) {
+	before, err := c.GetUnreadCount(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := c.MarkAllRead(ctx, userID); err != nil {
+		return nil, err
+	}
+
+	after, err := c.GetUnreadCount(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &MarkAllReadResponse{UnreadCounts: after.UnreadCounts, Response: after.Response}
+	for _, ch := range before.Channels {
+		if ch.UnreadCount > 0 {
+			resp.ChannelsMarked++
+		}
+	}
+	return resp, nil
+}
+
 // GetMessage returns message by ID.
 func (c *Client) GetMessage(ctx context.Context, msgID string) (*MessageResponse, error) {
+	return c.GetMessageWithOptions(ctx, msgID, false)
+}
+
+// GetMessageWithOptions returns message by ID. If showDeleted is true, the original
+// content of a soft deleted message is returned, e.g. for moderators reviewing reports.
+func (c *Client) GetMessageWithOptions(ctx context.Context, msgID string, showDeleted bool) (*MessageResponse, error) {
 	if msgID == "" {
 		return nil, errors.New("message ID must be not empty")
 	}
 
 	p := path.Join("messages", url.PathEscape(msgID))
 
+	var params url.Values
+	if showDeleted {
+		params = url.Values{"show_deleted_message": []string{"true"}}
+	}
+
 	var resp MessageResponse
-	err := c.makeRequest(ctx, http.MethodGet, p, nil, nil, &resp)
+	err := c.makeRequest(ctx, http.MethodGet, p, params, nil, &resp)
 	return &resp, err
 }
 
@@ -373,6 +510,29 @@ func (c *Client) HardDeleteMessage(ctx context.Context, msgID string) (*Response
 	return c.deleteMessage(ctx, msgID, true)
 }
 
+// UndeleteMessage restores the soft deleted message with given msgID on behalf of the user with given userID.
+// Hard deleted messages can't be restored.
+func (c *Client) UndeleteMessage(ctx context.Context, msgID, userID string) (*MessageResponse, error) {
+	switch {
+	case msgID == "":
+		return nil, errors.New("message ID must be not empty")
+	case userID == "":
+		return nil, errors.New("user ID must be not empty")
+	}
+
+	data := map[string]interface{}{
+		"restore": true,
+		"unset":   []string{"deleted_at"},
+		"user_id": userID,
+	}
+
+	p := path.Join("messages", url.PathEscape(msgID))
+
+	var resp MessageResponse
+	err := c.makeRequest(ctx, http.MethodPost, p, nil, data, &resp)
+	return &resp, err
+}
+
 func (c *Client) deleteMessage(ctx context.Context, msgID string, hard bool) (*Response, error) {
 	if msgID == "" {
 		return nil, errors.New("message ID must be not empty")
@@ -389,6 +549,25 @@ func (c *Client) deleteMessage(ctx context.Context, msgID string, hard bool) (*R
 	return &resp, err
 }
 
+// CommitMessage commits the pending message with given msgID, making it visible in the channel.
+// See MessagePending to send a pending message.
+func (c *Client) CommitMessage(ctx context.Context, msgID string) (*MessageResponse, error) {
+	if msgID == "" {
+		return nil, errors.New("message ID must be not empty")
+	}
+
+	p := path.Join("messages", url.PathEscape(msgID), "commit")
+
+	var resp MessageResponse
+	err := c.makeRequest(ctx, http.MethodPost, p, nil, nil, &resp)
+	return &resp, err
+}
+
+// DeletePendingMessage rejects the pending message with given msgID by permanently deleting it.
+func (c *Client) DeletePendingMessage(ctx context.Context, msgID string) (*Response, error) {
+	return c.deleteMessage(ctx, msgID, true)
+}
+
 type MessageFlag struct {
 	CreatedByAutomod bool `json:"created_by_automod"`
 	ModerationResult *struct {
@@ -424,6 +603,9 @@ type MessageFlag struct {
 	User    *User    `json:"user"`
 	Message *Message `json:"message"`
 
+	Reason string                 `json:"reason,omitempty"`
+	Custom map[string]interface{} `json:"custom,omitempty"`
+
 	CreatedAt  time.Time `json:"created_at"`
 	UpdatedAt  time.Time `json:"updated_at"`
 	ReviewedAt time.Time `json:"reviewed_at"`
@@ -452,6 +634,55 @@ func (c *Client) FlagMessage(ctx context.Context, msgID, userID string) (*Respon
 	return &resp, err
 }
 
+// Flag is a flag created on a message or a user.
+type Flag struct {
+	CreatedByAutomod bool   `json:"created_by_automod"`
+	User             *User  `json:"user"` // user who created the flag
+	TargetMessageID  string `json:"target_message_id,omitempty"`
+	TargetUser       *User  `json:"target_user,omitempty"`
+
+	Reason string                 `json:"reason,omitempty"`
+	Custom map[string]interface{} `json:"custom,omitempty"`
+
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+type FlagResponse struct {
+	Flag *Flag `json:"flag"`
+	Response
+}
+
+// FlagMessageWithOptions flags the message with given msgID with a reason, e.g. "spam",
+// and custom data, and returns the created flag. reason and custom are optional.
+func (c *Client) FlagMessageWithOptions(ctx context.Context, msgID, userID, reason string, custom map[string]interface{}) (*FlagResponse, error) {
+	switch {
+	case msgID == "":
+		return nil, errors.New("message ID is empty")
+	case userID == "":
+		return nil, errors.New("user ID is empty")
+	}
+
+	data := map[string]interface{}{
+		"target_message_id": msgID,
+		"user_id":           userID,
+	}
+	return c.flag(ctx, data, reason, custom)
+}
+
+func (c *Client) flag(ctx context.Context, data map[string]interface{}, reason string, custom map[string]interface{}) (*FlagResponse, error) {
+	if reason != "" {
+		data["reason"] = reason
+	}
+	if len(custom) > 0 {
+		data["custom"] = custom
+	}
+
+	var resp FlagResponse
+	err := c.makeRequest(ctx, http.MethodPost, "moderation/flag", nil, data, &resp)
+	return &resp, err
+}
+
 type RepliesResponse struct {
 	Messages []*Message `json:"messages"`
 	Response
@@ -471,6 +702,69 @@ func (ch *Channel) GetReplies(ctx context.Context, parentID string, options map[
 	return &resp, err
 }
 
+// RepliesOptions are the pagination options of GetRepliesPaginated.
+// Replies are ordered from oldest to newest within a page.
+type RepliesOptions struct {
+	Limit int
+	// IDLT returns replies older than the reply with this ID.
+	IDLT string
+	// IDGT returns replies newer than the reply with this ID.
+	IDGT           string
+	CreatedAtAfter *time.Time
+}
+
+func (o RepliesOptions) values() url.Values {
+	values := url.Values{}
+	if o.Limit > 0 {
+		values.Set("limit", strconv.Itoa(o.Limit))
+	}
+	if o.IDLT != "" {
+		values.Set("id_lt", o.IDLT)
+	}
+	if o.IDGT != "" {
+		values.Set("id_gt", o.IDGT)
+	}
+	if o.CreatedAtAfter != nil {
+		values.Set("created_at_after", o.CreatedAtAfter.Format(time.RFC3339Nano))
+	}
+	return values
+}
+
+// OlderRepliesOptions returns the options of the page of replies preceding this one,
+// which was loaded with opts. It returns nil when there are no older replies, which is
+// when the page holds fewer replies than opts.Limit.
+func (r *RepliesResponse) OlderRepliesOptions(opts RepliesOptions) *RepliesOptions {
+	if len(r.Messages) == 0 || (opts.Limit > 0 && len(r.Messages) < opts.Limit) {
+		return nil
+	}
+
+	return &RepliesOptions{
+		Limit: opts.Limit,
+		IDLT:  r.Messages[0].ID,
+	}
+}
+
+// ResumeOptions returns the GetRepliesPaginated options which load up to limit replies posted after
+// the last reply of this page. The cursor is the message ID of that reply, so unlike an offset it stays
+// valid when new replies are added, and can be stored to resume loading a thread after a reconnect.
+// It returns nil when the page is empty; keep using the previous options in that case.
+func (r *RepliesResponse) ResumeOptions(limit int) *RepliesOptions {
+	if len(r.Messages) == 0 {
+		return nil
+	}
+
+	return &RepliesOptions{
+		Limit: limit,
+		IDGT:  r.Messages[len(r.Messages)-1].ID,
+	}
+}
+
+// GetRepliesPaginated returns a page of replies for a parent message. Paginate by
+// message ID rather than offset, so pages stay stable while new replies are added.
+func (ch *Channel) GetRepliesPaginated(ctx context.Context, parentID string, opts RepliesOptions) (*RepliesResponse, error) {
+	return ch.GetReplies(ctx, parentID, opts.values())
+}
+
 type sendActionRequest struct {
 	MessageID string            `json:"message_id"`
 	FormData  map[string]string `json:"form_data"`
@@ -494,16 +788,92 @@ func (ch *Channel) SendAction(ctx context.Context, msgID string, formData map[st
 	return &resp, err
 }
 
-type TranslationResponse struct {
-	Message *Message `json:"message"`
-	Response
-}
+// TranslationResponse is kept for backwards compatibility, it is the same as MessageResponse.
+type TranslationResponse = MessageResponse
 
 // TranslateMessage translates the message with given msgID to the given language.
-func (c *Client) TranslateMessage(ctx context.Context, msgID, language string) (*TranslationResponse, error) {
-	p := "messages/" + url.PathEscape(msgID) + "/translate"
-	var resp TranslationResponse
+// The translated text is available in the I18n field of the returned message, keyed by "{language}_text".
+func (c *Client) TranslateMessage(ctx context.Context, msgID, language string) (*MessageResponse, error) {
+	switch {
+	case msgID == "":
+		return nil, errors.New("message ID must be not empty")
+	case language == "":
+		return nil, errors.New("language must be not empty")
+	}
+
+	p := path.Join("messages", url.PathEscape(msgID), "translate")
+
+	var resp MessageResponse
 	err := c.makeRequest(ctx, http.MethodPost, p, nil, map[string]string{"language": language}, &resp)
 
 	return &resp, err
 }
+
+// MessageHistoryEntry is a previous version of an edited message.
+type MessageHistoryEntry struct {
+	MessageID          string        `json:"message_id"`
+	MessageUpdatedByID string        `json:"message_updated_by_id"`
+	MessageUpdatedAt   time.Time     `json:"message_updated_at"`
+	Text               string        `json:"text"`
+	Attachments        []*Attachment `json:"attachments"`
+
+	ExtraData map[string]interface{} `json:"-"`
+}
+
+type messageHistoryEntryForJSON MessageHistoryEntry
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (m *MessageHistoryEntry) UnmarshalJSON(data []byte) error {
+	var m2 messageHistoryEntryForJSON
+	if err := json.Unmarshal(data, &m2); err != nil {
+		return err
+	}
+	*m = MessageHistoryEntry(m2)
+
+	if err := json.Unmarshal(data, &m.ExtraData); err != nil {
+		return err
+	}
+	removeFromMap(m.ExtraData, *m)
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler.
+func (m MessageHistoryEntry) MarshalJSON() ([]byte, error) {
+	return addToMapAndMarshal(m.ExtraData, messageHistoryEntryForJSON(m))
+}
+
+type MessageHistoryOptions struct {
+	Sort  []*SortOption `json:"sort,omitempty"`
+	Limit int           `json:"limit,omitempty"`
+	Next  string        `json:"next,omitempty"`
+	Prev  string        `json:"prev,omitempty"`
+}
+
+type MessageHistoryResponse struct {
+	MessageHistory []*MessageHistoryEntry `json:"message_history"`
+	Next           string                 `json:"next,omitempty"`
+	Prev           string                 `json:"prev,omitempty"`
+	Response
+}
+
+// GetMessageHistory returns the previous versions of the message with given msgID.
+// Edit history is only retained when it is enabled with AppSettings.MessageHistoryEnabled,
+// or ChannelConfig.MessageHistory for a channel type. Every edit is then stored as a full copy
+// of the message, in addition to its current version, and counts towards the app's storage.
+func (c *Client) GetMessageHistory(ctx context.Context, msgID string, options *MessageHistoryOptions) (*MessageHistoryResponse, error) {
+	if msgID == "" {
+		return nil, errors.New("message ID must be not empty")
+	}
+
+	req := struct {
+		Filter map[string]interface{} `json:"filter"`
+		*MessageHistoryOptions
+	}{
+		Filter:                map[string]interface{}{"message_id": msgID},
+		MessageHistoryOptions: options,
+	}
+
+	var resp MessageHistoryResponse
+	err := c.makeRequest(ctx, http.MethodPost, "messages/history", nil, req, &resp)
+	return &resp, err
+}
@@ -2,6 +2,7 @@ package stream_chat
 
 import (
 	"context"
+	"encoding/json"
 	"testing"
 	"time"
 
@@ -23,6 +24,123 @@ func TestClient_TranslateMessage(t *testing.T) {
 	require.Equal(t, "mensaje de prueba", translated.Message.I18n["es_text"])
 }
 
+func TestClient_SendMessage_WithID(t *testing.T) {
+	c := initClient(t)
+	user := randomUser(t, c)
+	ch := initChannel(t, c, user.ID)
+	ctx := context.Background()
+
+	id := randomString(20)
+	messageResp, err := ch.SendMessage(ctx, &Message{ID: id, Text: "optimistic message"}, user.ID)
+	require.NoError(t, err)
+	require.Equal(t, id, messageResp.Message.ID)
+
+	_, err = ch.SendMessage(ctx, &Message{ID: id, Text: "duplicate message"}, user.ID)
+	require.Error(t, err)
+
+	_, err = ch.SendMessage(ctx, &Message{ID: "invalid id", Text: "test message"}, user.ID)
+	require.Error(t, err)
+}
+
+func TestClient_SendMessage_Silent(t *testing.T) {
+	c := initClient(t)
+	user := randomUser(t, c)
+	ch := initChannel(t, c, user.ID)
+	ctx := context.Background()
+
+	messageResp, err := ch.SendMessage(ctx, &Message{Text: "user joined the channel", Silent: true}, user.ID)
+	require.NoError(t, err)
+	require.True(t, messageResp.Message.Silent)
+
+	gotMsg, err := c.GetMessage(ctx, messageResp.Message.ID)
+	require.NoError(t, err)
+	require.True(t, gotMsg.Message.Silent)
+}
+
+func TestClient_SendMessage_QuotedMessage(t *testing.T) {
+	c := initClient(t)
+	user := randomUser(t, c)
+	ch := initChannel(t, c, user.ID)
+	ctx := context.Background()
+
+	quoted, err := ch.SendMessage(ctx, &Message{Text: "quoted message"}, user.ID)
+	require.NoError(t, err)
+
+	messageResp, err := ch.SendMessage(ctx, &Message{Text: "quote reply", QuotedMessageID: quoted.Message.ID}, user.ID)
+	require.NoError(t, err)
+	require.Equal(t, quoted.Message.ID, messageResp.Message.QuotedMessageID)
+	require.NotNil(t, messageResp.Message.QuotedMessage)
+	require.Equal(t, "quoted message", messageResp.Message.QuotedMessage.Text)
+}
+
+func TestClient_SendMessage_MentionedUsers(t *testing.T) {
+	c := initClient(t)
+	user := randomUser(t, c)
+	mentioned := randomUser(t, c)
+	ch := initChannel(t, c, user.ID, mentioned.ID)
+	ctx := context.Background()
+
+	msg := &Message{
+		Text:           "hello @" + mentioned.ID,
+		MentionedUsers: []*User{{ID: mentioned.ID}},
+	}
+	messageResp, err := ch.SendMessage(ctx, msg, user.ID)
+	require.NoError(t, err)
+
+	gotMsg, err := c.GetMessage(ctx, messageResp.Message.ID)
+	require.NoError(t, err)
+	require.Len(t, gotMsg.Message.MentionedUsers, 1)
+	require.Equal(t, mentioned.ID, gotMsg.Message.MentionedUsers[0].ID)
+	require.NotNil(t, gotMsg.Message.MentionedUsers[0].CreatedAt, "mentioned users should be full user objects")
+}
+
+func TestMessage_RestrictedVisibilityOmitted(t *testing.T) {
+	msg := &Message{Text: "note", User: &User{ID: "bob"}}
+	b, err := json.Marshal(msg.toRequest())
+	require.NoError(t, err)
+	require.NotContains(t, string(b), "restricted_visibility")
+
+	msg.RestrictedVisibility = []string{"moderator"}
+	b, err = json.Marshal(msg.toRequest())
+	require.NoError(t, err)
+	require.Contains(t, string(b), `"restricted_visibility":["moderator"]`)
+}
+
+func TestClient_SendMessage_RestrictedVisibility(t *testing.T) {
+	c := initClient(t)
+	user := randomUser(t, c)
+	moderator := randomUser(t, c)
+	ch := initChannel(t, c, user.ID, moderator.ID)
+	ctx := context.Background()
+
+	msg := &Message{Text: "moderator only note", RestrictedVisibility: []string{moderator.ID}}
+	messageResp, err := ch.SendMessage(ctx, msg, user.ID)
+	require.NoError(t, err)
+	require.Equal(t, []string{moderator.ID}, messageResp.Message.RestrictedVisibility)
+
+	msg = messageResp.Message
+	msg.RestrictedVisibility = []string{moderator.ID, user.ID}
+	updated, err := c.UpdateMessage(ctx, msg, msg.ID)
+	require.NoError(t, err)
+	require.ElementsMatch(t, []string{moderator.ID, user.ID}, updated.Message.RestrictedVisibility)
+}
+
+func TestClient_FlagMessageWithOptions(t *testing.T) {
+	c := initClient(t)
+	user := randomUser(t, c)
+	flagger := randomUser(t, c)
+	ch := initChannel(t, c, user.ID)
+	ctx := context.Background()
+
+	messageResp, err := ch.SendMessage(ctx, &Message{Text: "buy now"}, user.ID)
+	require.NoError(t, err)
+
+	resp, err := c.FlagMessageWithOptions(ctx, messageResp.Message.ID, flagger.ID, "spam", map[string]interface{}{"source": "report_button"})
+	require.NoError(t, err)
+	require.Equal(t, messageResp.Message.ID, resp.Flag.TargetMessageID)
+	require.Equal(t, flagger.ID, resp.Flag.User.ID)
+}
+
 func TestClient_SendMessage_Pending(t *testing.T) {
 	c := initClient(t)
 	user := randomUser(t, c)
@@ -38,12 +156,85 @@ func TestClient_SendMessage_Pending(t *testing.T) {
 	messageResp, err := resp1.Channel.SendMessage(ctx, msg, user.ID, MessagePending, MessagePendingMessageMetadata(metadata))
 	require.NoError(t, err)
 	require.Equal(t, metadata, messageResp.PendingMessageMetadata)
+	require.NotEmpty(t, messageResp.Duration)
 
 	gotMsg, err := c.GetMessage(ctx, messageResp.Message.ID)
 	require.NoError(t, err)
 	require.Equal(t, metadata, gotMsg.PendingMessageMetadata)
 }
 
+func TestClient_CommitMessage(t *testing.T) {
+	c := initClient(t)
+	user := randomUser(t, c)
+	ch := initChannel(t, c, user.ID)
+	ctx := context.Background()
+
+	messageResp, err := ch.SendMessage(ctx, &Message{Text: "test pending message"}, user.ID, MessagePending)
+	require.NoError(t, err)
+
+	commitResp, err := c.CommitMessage(ctx, messageResp.Message.ID)
+	require.NoError(t, err)
+	require.Equal(t, messageResp.Message.ID, commitResp.Message.ID)
+
+	_, err = c.CommitMessage(ctx, "")
+	require.Error(t, err)
+}
+
+func TestClient_DeletePendingMessage(t *testing.T) {
+	c := initClient(t)
+	user := randomUser(t, c)
+	ch := initChannel(t, c, user.ID)
+	ctx := context.Background()
+
+	messageResp, err := ch.SendMessage(ctx, &Message{Text: "test pending message"}, user.ID, MessagePending)
+	require.NoError(t, err)
+
+	_, err = c.DeletePendingMessage(ctx, messageResp.Message.ID)
+	require.NoError(t, err)
+
+	_, err = c.GetMessage(ctx, messageResp.Message.ID)
+	require.Error(t, err)
+}
+
+func TestClient_GetMessageWithOptions_ShowDeleted(t *testing.T) {
+	c := initClient(t)
+	user := randomUser(t, c)
+	ch := initChannel(t, c, user.ID)
+	ctx := context.Background()
+
+	messageResp, err := ch.SendMessage(ctx, &Message{Text: "to be deleted"}, user.ID)
+	require.NoError(t, err)
+
+	_, err = c.DeleteMessage(ctx, messageResp.Message.ID)
+	require.NoError(t, err)
+
+	gotMsg, err := c.GetMessageWithOptions(ctx, messageResp.Message.ID, true)
+	require.NoError(t, err)
+	require.NotNil(t, gotMsg.Message.DeletedAt)
+	require.Equal(t, "to be deleted", gotMsg.Message.Text)
+}
+
+func TestClient_UndeleteMessage(t *testing.T) {
+	c := initClient(t)
+	user := randomUser(t, c)
+	ch := initChannel(t, c, user.ID)
+	ctx := context.Background()
+
+	messageResp, err := ch.SendMessage(ctx, &Message{Text: "deleted by mistake"}, user.ID)
+	require.NoError(t, err)
+
+	_, err = c.DeleteMessage(ctx, messageResp.Message.ID)
+	require.NoError(t, err)
+
+	_, err = c.UndeleteMessage(ctx, messageResp.Message.ID, "")
+	require.Error(t, err)
+
+	restored, err := c.UndeleteMessage(ctx, messageResp.Message.ID, user.ID)
+	require.NoError(t, err)
+	require.Nil(t, restored.Message.DeletedAt)
+	require.Equal(t, "deleted by mistake", restored.Message.Text)
+}
+
 func TestClient_SendMessage_SkipEnrichURL(t *testing.T) {
 	c := initClient(t)
 	user := randomUser(t, c)
@@ -65,6 +256,39 @@ func TestClient_SendMessage_SkipEnrichURL(t *testing.T) {
 	require.Len(t, gotMsg.Message.Attachments, 0)
 }
 
+func TestClient_SendMessage_KeepChannelHidden(t *testing.T) {
+	c := initClient(t)
+	user := randomUser(t, c)
+	ch := initChannel(t, c, user.ID)
+	ctx := context.Background()
+
+	_, err := ch.Hide(ctx, user.ID)
+	require.NoError(t, err)
+
+	_, err = ch.SendMessage(ctx, &Message{Text: "quiet message"}, user.ID, MessageKeepChannelHidden, MessageForceModeration)
+	require.NoError(t, err)
+
+	resp, err := c.QueryChannels(ctx, &QueryOption{
+		Filter: map[string]interface{}{"cid": ch.CID, "hidden": true},
+		UserID: user.ID,
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.Channels, 1, "channel is still hidden")
+}
+
+func TestClient_SendMessage_CreatedAt(t *testing.T) {
+	c := initClient(t)
+	user := randomUser(t, c)
+	ch := initChannel(t, c, user.ID)
+	ctx := context.Background()
+
+	createdAt := time.Now().Add(-24 * time.Hour).UTC().Truncate(time.Millisecond)
+	messageResp, err := ch.SendMessage(ctx, &Message{Text: "backfilled", CreatedAt: &createdAt}, user.ID, MessageSkipPush)
+	require.NoError(t, err)
+	require.NotNil(t, messageResp.Message.CreatedAt)
+	require.True(t, createdAt.Equal(*messageResp.Message.CreatedAt))
+}
+
 func TestClient_PinMessage(t *testing.T) {
 	c := initClient(t)
 	userA := randomUser(t, c)
@@ -118,3 +342,58 @@ func TestClient_PinMessage(t *testing.T) {
 	require.Zero(t, msg.PinnedAt)
 	require.Zero(t, msg.PinnedBy)
 }
+
+func TestClient_GetMessageHistory(t *testing.T) {
+	c := initClient(t)
+	ctx := context.Background()
+
+	// history is only kept for messages edited while it is enabled
+	_, err := c.UpdateAppSettings(ctx, NewAppSettings().SetMessageHistoryEnabled(true))
+	require.NoError(t, err)
+
+	user := randomUser(t, c)
+	ch := initChannel(t, c, user.ID)
+
+	messageResp, err := ch.SendMessage(ctx, &Message{Text: "original text"}, user.ID)
+	require.NoError(t, err)
+	msg := messageResp.Message
+
+	_, err = c.UpdateMessage(ctx, &Message{Text: "edited text", User: user}, msg.ID)
+	require.NoError(t, err)
+
+	_, err = c.GetMessageHistory(ctx, "", nil)
+	require.Error(t, err)
+
+	history, err := c.GetMessageHistory(ctx, msg.ID, &MessageHistoryOptions{
+		Sort: []*SortOption{{Field: "message_updated_at", Direction: -1}},
+	})
+	require.NoError(t, err)
+	require.Len(t, history.MessageHistory, 1)
+	require.Equal(t, msg.ID, history.MessageHistory[0].MessageID)
+	require.Equal(t, "original text", history.MessageHistory[0].Text)
+	require.Equal(t, user.ID, history.MessageHistory[0].MessageUpdatedByID)
+}
+
+func TestMessageResponse_ErrorMessage(t *testing.T) {
+	const fixture = `{
+		"message": {
+			"id": "4e2f3c1a-error",
+			"text": "Message was blocked by moderation policies",
+			"type": "error",
+			"user": {"id": "bob"}
+		},
+		"duration": "1.23ms"
+	}`
+
+	var resp MessageResponse
+	require.NoError(t, json.Unmarshal([]byte(fixture), &resp))
+
+	err := resp.checkMessageType()
+	var msgErr *MessageError
+	require.ErrorAs(t, err, &msgErr)
+	require.Equal(t, "4e2f3c1a-error", msgErr.Message.ID)
+	require.Equal(t, "message rejected: Message was blocked by moderation policies", err.Error())
+
+	resp.Message.Type = MessageTypeRegular
+	require.NoError(t, resp.checkMessageType())
+}
@@ -0,0 +1,49 @@
+package stream_chat
+
+import (
+	"context"
+	"errors"
+	"net/http"
+)
+
+// ModerationAction is the action recommended by automated moderation.
+type ModerationAction string
+
+const (
+	ModerationActionKeep   ModerationAction = "keep"
+	ModerationActionFlag   ModerationAction = "flag"
+	ModerationActionBounce ModerationAction = "bounce"
+	ModerationActionRemove ModerationAction = "remove"
+)
+
+type ModerationResponse struct {
+	// RecommendedAction is what automod would do if the text was sent as a message.
+	RecommendedAction ModerationAction `json:"recommended_action"`
+	// Scores holds the score per category, e.g. "toxic", "spam" or "explicit".
+	Scores map[string]float64 `json:"scores"`
+	// BlockedWord and BlocklistName are set when the text matches a blocklist.
+	BlockedWord   string `json:"blocked_word,omitempty"`
+	BlocklistName string `json:"blocklist_name,omitempty"`
+
+	Response
+}
+
+// CheckModeration runs automated moderation on the given text as if it was sent by
+// the user with the given userID, without sending it. It can be used to pre-screen messages.
+func (c *Client) CheckModeration(ctx context.Context, text, userID string) (*ModerationResponse, error) {
+	switch {
+	case text == "":
+		return nil, errors.New("text is empty")
+	case userID == "":
+		return nil, errors.New("user ID is empty")
+	}
+
+	data := map[string]string{
+		"text":    text,
+		"user_id": userID,
+	}
+
+	var resp ModerationResponse
+	err := c.makeRequest(ctx, http.MethodPost, "moderation/check", nil, data, &resp)
+	return &resp, err
+}
@@ -0,0 +1,21 @@
+package stream_chat
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_CheckModeration(t *testing.T) {
+	c := initClient(t)
+	ctx := context.Background()
+	user := randomUser(t, c)
+
+	resp, err := c.CheckModeration(ctx, "hello there", user.ID)
+	require.NoError(t, err)
+	require.NotEmpty(t, resp.RecommendedAction)
+
+	_, err = c.CheckModeration(ctx, "", user.ID)
+	require.Error(t, err)
+}
@@ -13,6 +13,7 @@ type Permission struct {
 	Name        string                 `json:"name"`
 	Description string                 `json:"description"`
 	Action      string                 `json:"action"`
+	Resource    string                 `json:"resource,omitempty"`
 	Owner       bool                   `json:"owner"`
 	SameTeam    bool                   `json:"same_team"`
 	Condition   map[string]interface{} `json:"condition"`
@@ -23,7 +24,7 @@ type Permission struct {
 type Role struct {
 	Name      string    `json:"name"`
 	Custom    bool      `json:"custom"`
-	Scopes    []string  `json:"scoped"`
+	Scopes    []string  `json:"scopes"`
 	CreatedAt time.Time `json:"created_at"`
 	UpdatedAt time.Time `json:"updated_at"`
 }
@@ -32,13 +33,27 @@ type PermissionClient struct {
 	client *Client
 }
 
+type RoleResponse struct {
+	Role *Role `json:"role"`
+	Response
+}
+
 // CreateRole creates a new role.
 func (p *PermissionClient) CreateRole(ctx context.Context, name string) (*Response, error) {
+	resp, err := p.CreateRoleWithResponse(ctx, name)
+	if resp == nil {
+		return nil, err
+	}
+	return &resp.Response, err
+}
+
+// CreateRoleWithResponse creates a new role and returns it.
+func (p *PermissionClient) CreateRoleWithResponse(ctx context.Context, name string) (*RoleResponse, error) {
 	if name == "" {
 		return nil, errors.New("name is required")
 	}
 
-	var resp Response
+	var resp RoleResponse
 	err := p.client.makeRequest(ctx, http.MethodPost, "roles", nil, map[string]interface{}{
 		"name": name,
 	}, &resp)
@@ -70,6 +85,11 @@ func (p *PermissionClient) ListRoles(ctx context.Context) (*RolesResponse, error
 	return &r, err
 }
 
+type PermissionResponse struct {
+	Permission *Permission `json:"permission"`
+	Response
+}
+
 // CreatePermission creates a new permission.
 func (p *PermissionClient) CreatePermission(ctx context.Context, perm *Permission) (*Response, error) {
 	var resp Response
@@ -77,11 +97,19 @@ func (p *PermissionClient) CreatePermission(ctx context.Context, perm *Permissio
 	return &resp, err
 }
 
-type GetPermissionResponse struct {
-	Permission *Permission `json:"permission"`
-	Response
+// CreatePermissionWithResponse creates a new permission and returns it.
+func (p *PermissionClient) CreatePermissionWithResponse(ctx context.Context, perm *Permission) (*PermissionResponse, error) {
+	if perm == nil {
+		return nil, errors.New("permission is required")
+	}
+
+	var resp PermissionResponse
+	err := p.client.makeRequest(ctx, http.MethodPost, "permissions", nil, perm, &resp)
+	return &resp, err
 }
 
+type GetPermissionResponse = PermissionResponse
+
 // GetPermission returns a permission by id.
 func (p *PermissionClient) GetPermission(ctx context.Context, id string) (*GetPermissionResponse, error) {
 	if id == "" {
@@ -97,13 +125,23 @@ func (p *PermissionClient) GetPermission(ctx context.Context, id string) (*GetPe
 
 // UpdatePermission updates an existing permission by id. Only custom permissions can be updated.
 func (p *PermissionClient) UpdatePermission(ctx context.Context, id string, perm *Permission) (*Response, error) {
+	resp, err := p.UpdatePermissionWithResponse(ctx, id, perm)
+	if resp == nil {
+		return nil, err
+	}
+	return &resp.Response, err
+}
+
+// UpdatePermissionWithResponse updates an existing permission by id and returns it.
+// Only custom permissions can be updated.
+func (p *PermissionClient) UpdatePermissionWithResponse(ctx context.Context, id string, perm *Permission) (*PermissionResponse, error) {
 	if id == "" {
 		return nil, errors.New("id is required")
 	}
 
 	uri := path.Join("permissions", id)
 
-	var resp Response
+	var resp PermissionResponse
 	err := p.client.makeRequest(ctx, http.MethodPut, uri, nil, perm, &resp)
 	return &resp, err
 }
@@ -74,3 +74,54 @@ func TestPermissions_PermissionEndpoints(t *testing.T) {
 		}
 	})
 }
+
+func TestPermissions_CreateRoleWithResponse(t *testing.T) {
+	c := initClient(t)
+	p := c.Permissions()
+	ctx := context.Background()
+	roleName := randomString(12)
+
+	roleResp, err := p.CreateRoleWithResponse(ctx, roleName)
+	require.NoError(t, err)
+	assert.Equal(t, roleName, roleResp.Role.Name)
+	assert.True(t, roleResp.Role.Custom)
+
+	t.Cleanup(func() {
+		_, _ = p.DeleteRole(ctx, roleName)
+	})
+}
+
+func TestPermissions_PermissionWithResponse(t *testing.T) {
+	c := initClient(t)
+	p := c.Permissions()
+	ctx := context.Background()
+	permName := randomString(12)
+
+	created, err := p.CreatePermissionWithResponse(ctx, &Permission{
+		ID:          permName,
+		Name:        permName,
+		Action:      "DeleteChannel",
+		Description: "integration test",
+		Condition: map[string]interface{}{
+			"$subject.magic_custom_field": map[string]string{"$eq": "true"},
+		},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, permName, created.Permission.ID)
+	assert.True(t, created.Permission.Custom)
+
+	t.Cleanup(func() {
+		_, _ = p.DeletePermission(ctx, permName)
+	})
+
+	updated, err := p.UpdatePermissionWithResponse(ctx, permName, &Permission{
+		Name:        permName,
+		Action:      "DeleteChannel",
+		Description: "integration test",
+		Condition: map[string]interface{}{
+			"$subject.magic_custom_field": map[string]string{"$eq": "false"},
+		},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, permName, updated.Permission.ID)
+}
@@ -0,0 +1,227 @@
+package stream_chat
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"path"
+	"time"
+)
+
+type VotingVisibility string
+
+const (
+	VotingVisibilityPublic    VotingVisibility = "public"
+	VotingVisibilityAnonymous VotingVisibility = "anonymous"
+)
+
+type Poll struct {
+	ID                        string           `json:"id,omitempty"`
+	Name                      string           `json:"name"`
+	Description               string           `json:"description,omitempty"`
+	VotingVisibility          VotingVisibility `json:"voting_visibility,omitempty"`
+	EnforceUniqueVote         bool             `json:"enforce_unique_vote"`
+	MaxVotesAllowed           *int             `json:"max_votes_allowed,omitempty"`
+	AllowUserSuggestedOptions bool             `json:"allow_user_suggested_options"`
+	AllowAnswers              bool             `json:"allow_answers"`
+	IsClosed                  bool             `json:"is_closed"`
+	Options                   []*PollOption    `json:"options,omitempty"`
+
+	// The fields below are set by the server.
+	VoteCount           int                    `json:"vote_count,omitempty"`
+	VoteCountsByOption  map[string]int         `json:"vote_counts_by_option,omitempty"`
+	AnswersCount        int                    `json:"answers_count,omitempty"`
+	LatestVotesByOption map[string][]*PollVote `json:"latest_votes_by_option,omitempty"`
+	LatestAnswers       []*PollVote            `json:"latest_answers,omitempty"`
+	OwnVotes            []*PollVote            `json:"own_votes,omitempty"` // votes of the requesting user
+	CreatedByID         string                 `json:"created_by_id,omitempty"`
+	CreatedBy           *User                  `json:"created_by,omitempty"`
+	CreatedAt           *time.Time             `json:"created_at,omitempty"`
+	UpdatedAt           *time.Time             `json:"updated_at,omitempty"`
+
+	Custom map[string]interface{} `json:"custom,omitempty"`
+}
+
+type PollOption struct {
+	ID     string                 `json:"id,omitempty"`
+	Text   string                 `json:"text"`
+	Custom map[string]interface{} `json:"custom,omitempty"`
+}
+
+type PollVote struct {
+	ID         string     `json:"id,omitempty"`
+	PollID     string     `json:"poll_id,omitempty"`
+	OptionID   string     `json:"option_id,omitempty"`
+	IsAnswer   bool       `json:"is_answer,omitempty"`
+	AnswerText string     `json:"answer_text,omitempty"`
+	UserID     string     `json:"user_id,omitempty"`
+	User       *User      `json:"user,omitempty"`
+	CreatedAt  *time.Time `json:"created_at,omitempty"`
+	UpdatedAt  *time.Time `json:"updated_at,omitempty"`
+}
+
+type PollResponse struct {
+	Poll *Poll `json:"poll"`
+	Response
+}
+
+type PollOptionResponse struct {
+	PollOption *PollOption `json:"poll_option"`
+	Response
+}
+
+type PollVoteResponse struct {
+	Vote *PollVote `json:"vote"`
+	Poll *Poll     `json:"poll"`
+	Response
+}
+
+type pollRequest struct {
+	*Poll
+	UserID string `json:"user_id"`
+}
+
+// CreatePoll creates a poll on behalf of the user with given ID.
+// To attach the poll to a message, send the message with PollID set to the ID of the created poll.
+func (c *Client) CreatePoll(ctx context.Context, poll *Poll, userID string) (*PollResponse, error) {
+	switch {
+	case poll == nil:
+		return nil, errors.New("poll is nil")
+	case poll.Name == "":
+		return nil, errors.New("poll name must be not empty")
+	case userID == "":
+		return nil, errors.New("user ID must be not empty")
+	}
+
+	var resp PollResponse
+	err := c.makeRequest(ctx, http.MethodPost, "polls", nil, pollRequest{Poll: poll, UserID: userID}, &resp)
+	return &resp, err
+}
+
+// GetPoll returns the poll with given ID. OwnVotes contains the votes of the user with given userID.
+func (c *Client) GetPoll(ctx context.Context, pollID, userID string) (*PollResponse, error) {
+	if pollID == "" {
+		return nil, errors.New("poll ID must be not empty")
+	}
+
+	p := path.Join("polls", url.PathEscape(pollID))
+
+	params := url.Values{}
+	if userID != "" {
+		params.Set("user_id", userID)
+	}
+
+	var resp PollResponse
+	err := c.makeRequest(ctx, http.MethodGet, p, params, nil, &resp)
+	return &resp, err
+}
+
+// UpdatePoll fully replaces the poll with the ID of the given poll.
+func (c *Client) UpdatePoll(ctx context.Context, poll *Poll, userID string) (*PollResponse, error) {
+	switch {
+	case poll == nil:
+		return nil, errors.New("poll is nil")
+	case poll.ID == "":
+		return nil, errors.New("poll ID must be not empty")
+	case userID == "":
+		return nil, errors.New("user ID must be not empty")
+	}
+
+	var resp PollResponse
+	err := c.makeRequest(ctx, http.MethodPut, "polls", nil, pollRequest{Poll: poll, UserID: userID}, &resp)
+	return &resp, err
+}
+
+// DeletePoll deletes the poll with given ID.
+func (c *Client) DeletePoll(ctx context.Context, pollID, userID string) (*Response, error) {
+	if pollID == "" {
+		return nil, errors.New("poll ID must be not empty")
+	}
+
+	p := path.Join("polls", url.PathEscape(pollID))
+
+	params := url.Values{}
+	if userID != "" {
+		params.Set("user_id", userID)
+	}
+
+	var resp Response
+	err := c.makeRequest(ctx, http.MethodDelete, p, params, nil, &resp)
+	return &resp, err
+}
+
+// CreatePollOption adds a new option to the poll with given ID.
+func (c *Client) CreatePollOption(ctx context.Context, pollID string, option *PollOption, userID string) (*PollOptionResponse, error) {
+	switch {
+	case pollID == "":
+		return nil, errors.New("poll ID must be not empty")
+	case option == nil:
+		return nil, errors.New("poll option is nil")
+	case option.Text == "":
+		return nil, errors.New("poll option text must be not empty")
+	}
+
+	p := path.Join("polls", url.PathEscape(pollID), "options")
+
+	data := struct {
+		*PollOption
+		UserID string `json:"user_id,omitempty"`
+	}{
+		PollOption: option,
+		UserID:     userID,
+	}
+
+	var resp PollOptionResponse
+	err := c.makeRequest(ctx, http.MethodPost, p, nil, data, &resp)
+	return &resp, err
+}
+
+// CastVote casts a vote of the user with given ID on the poll attached to the message with given ID.
+// Set OptionID on the vote to vote for an option or AnswerText to answer the poll.
+func (c *Client) CastVote(ctx context.Context, messageID, pollID string, vote *PollVote, userID string) (*PollVoteResponse, error) {
+	switch {
+	case messageID == "":
+		return nil, errors.New("message ID must be not empty")
+	case pollID == "":
+		return nil, errors.New("poll ID must be not empty")
+	case vote == nil:
+		return nil, errors.New("vote is nil")
+	case userID == "":
+		return nil, errors.New("user ID must be not empty")
+	}
+
+	p := path.Join("messages", url.PathEscape(messageID), "polls", url.PathEscape(pollID), "vote")
+
+	data := map[string]interface{}{
+		"vote":    vote,
+		"user_id": userID,
+	}
+
+	var resp PollVoteResponse
+	err := c.makeRequest(ctx, http.MethodPost, p, nil, data, &resp)
+	return &resp, err
+}
+
+// RemoveVote removes the vote with given ID of the user with given ID.
+func (c *Client) RemoveVote(ctx context.Context, messageID, pollID, voteID, userID string) (*PollVoteResponse, error) {
+	switch {
+	case messageID == "":
+		return nil, errors.New("message ID must be not empty")
+	case pollID == "":
+		return nil, errors.New("poll ID must be not empty")
+	case voteID == "":
+		return nil, errors.New("vote ID must be not empty")
+	case userID == "":
+		return nil, errors.New("user ID must be not empty")
+	}
+
+	p := path.Join("messages", url.PathEscape(messageID), "polls", url.PathEscape(pollID), "vote", url.PathEscape(voteID))
+
+	params := url.Values{}
+	params.Set("user_id", userID)
+
+	var resp PollVoteResponse
+	err := c.makeRequest(ctx, http.MethodDelete, p, params, nil, &resp)
+	return &resp, err
+}
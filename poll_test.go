@@ -0,0 +1,61 @@
+package stream_chat
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_Polls(t *testing.T) {
+	c := initClient(t)
+	user := randomUser(t, c)
+	ch := initChannel(t, c, user.ID)
+	ctx := context.Background()
+
+	pollResp, err := c.CreatePoll(ctx, &Poll{
+		Name:              "favourite color",
+		EnforceUniqueVote: true,
+		Options:           []*PollOption{{Text: "red"}, {Text: "blue"}},
+	}, user.ID)
+	require.NoError(t, err)
+	poll := pollResp.Poll
+	require.NotEmpty(t, poll.ID)
+	require.Len(t, poll.Options, 2)
+	t.Cleanup(func() {
+		_, _ = c.DeletePoll(ctx, poll.ID, user.ID)
+	})
+
+	optionResp, err := c.CreatePollOption(ctx, poll.ID, &PollOption{Text: "green"}, user.ID)
+	require.NoError(t, err)
+	require.Equal(t, "green", optionResp.PollOption.Text)
+
+	msgResp, err := ch.SendMessage(ctx, &Message{Text: "vote", PollID: poll.ID}, user.ID)
+	require.NoError(t, err)
+	require.Equal(t, poll.ID, msgResp.Message.PollID)
+	require.NotNil(t, msgResp.Message.Poll)
+
+	voteResp, err := c.CastVote(ctx, msgResp.Message.ID, poll.ID, &PollVote{OptionID: poll.Options[0].ID}, user.ID)
+	require.NoError(t, err)
+	require.Equal(t, poll.Options[0].ID, voteResp.Vote.OptionID)
+
+	pollResp, err = c.GetPoll(ctx, poll.ID, user.ID)
+	require.NoError(t, err)
+	require.Equal(t, 1, pollResp.Poll.VoteCount)
+	require.Equal(t, 1, pollResp.Poll.VoteCountsByOption[poll.Options[0].ID])
+	require.Len(t, pollResp.Poll.OwnVotes, 1)
+
+	_, err = c.RemoveVote(ctx, msgResp.Message.ID, poll.ID, voteResp.Vote.ID, user.ID)
+	require.NoError(t, err)
+
+	poll.Name = "favourite colour"
+	poll.IsClosed = true
+	pollResp, err = c.UpdatePoll(ctx, poll, user.ID)
+	require.NoError(t, err)
+	require.Equal(t, "favourite colour", pollResp.Poll.Name)
+	require.True(t, pollResp.Poll.IsClosed)
+	require.Zero(t, pollResp.Poll.VoteCount)
+
+	_, err = c.DeletePoll(ctx, poll.ID, user.ID)
+	require.NoError(t, err)
+}
@@ -27,6 +27,16 @@ type SortOption struct {
 	Direction int    `json:"direction"` // [-1, 1]
 }
 
+// Asc returns a SortOption which sorts by field in ascending order.
+func Asc(field string) *SortOption {
+	return &SortOption{Field: field, Direction: 1}
+}
+
+// Desc returns a SortOption which sorts by field in descending order.
+func Desc(field string) *SortOption {
+	return &SortOption{Field: field, Direction: -1}
+}
+
 type queryRequest struct {
 	Watch    bool `json:"watch"`
 	State    bool `json:"state"`
@@ -66,10 +76,29 @@ type QueryUsersResponse struct {
 // QueryUsers returns list of users that match QueryOption.
 // If any number of SortOption are set, result will be sorted by field and direction in the order of sort options.
 func (c *Client) QueryUsers(ctx context.Context, q *QueryOption, sorters ...*SortOption) (*QueryUsersResponse, error) {
+	return c.QueryUsersWithOptions(ctx, &QueryUsersOptions{QueryOption: q}, sorters...)
+}
+
+type QueryUsersOptions struct {
+	*QueryOption
+
+	// Presence makes the response include the presence of the users,
+	// i.e. their Online and LastActive fields.
+	Presence bool
+}
+
+// QueryUsersWithOptions returns list of users that match the options.
+// If any number of SortOption are set, result will be sorted by field and direction in the order of sort options.
+func (c *Client) QueryUsersWithOptions(ctx context.Context, q *QueryUsersOptions, sorters ...*SortOption) (*QueryUsersResponse, error) {
+	if q == nil || q.QueryOption == nil {
+		return nil, errors.New("query option is nil")
+	}
+
 	qp := queryRequest{
 		FilterConditions: q.Filter,
 		Limit:            q.Limit,
 		Offset:           q.Offset,
+		Presence:         q.Presence,
 		Sort:             sorters,
 	}
 
@@ -86,6 +115,29 @@ func (c *Client) QueryUsers(ctx context.Context, q *QueryOption, sorters ...*Sor
 	return &resp, err
 }
 
+// SearchUsers returns up to limit users whose name or ID has a word starting with prefix,
+// e.g. for a mention picker.
+func (c *Client) SearchUsers(ctx context.Context, prefix string, limit int) ([]*User, error) {
+	switch {
+	case prefix == "":
+		return nil, errors.New("prefix is empty")
+	case limit <= 0:
+		return nil, errors.New("limit must be positive")
+	}
+
+	resp, err := c.QueryUsers(ctx, &QueryOption{
+		Filter: Filter{}.Or(
+			Filter{}.Autocomplete("name", prefix),
+			Filter{}.Autocomplete("id", prefix),
+		),
+		Limit: limit,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.Users, nil
+}
+
 type queryChannelResponse struct {
 	Channels []queryChannelResponseData `json:"channels"`
 	Response
@@ -117,6 +169,125 @@ func (c *Client) QueryChannels(ctx context.Context, q *QueryOption, sort ...*Sor
 		MessageLimit:     q.MessageLimit,
 	}
 
+	return c.queryChannels(ctx, qp)
+}
+
+// maxQueryChannelsLimit is the largest page size of QueryChannels.
+const maxQueryChannelsLimit = 30
+
+// ChannelIterator iterates over the channels matching a query, fetching them page by page.
+//
+//	it := client.QueryChannelsAll(ctx, &QueryOption{Filter: filter})
+//	for it.Next() {
+//		ch := it.Channel()
+//	}
+//	if err := it.Err(); err != nil {
+//		// handle the error
+//	}
+type ChannelIterator struct {
+	client *Client
+	ctx    context.Context
+	query  QueryOption
+	sort   []*SortOption
+
+	page     []*Channel
+	current  *Channel
+	lastPage bool
+	err      error
+}
+
+// QueryChannelsAll returns an iterator over all channels matching q, which fetches the
+// pages of q.Limit channels as they are needed, starting from q.Offset.
+// The iteration stops with an error when ctx is canceled, or right away if q is nil.
+// It doesn't return an iter.Seq2 since the module still targets Go 1.17.
+func (c *Client) QueryChannelsAll(ctx context.Context, q *QueryOption, sort ...*SortOption) *ChannelIterator {
+	if q == nil {
+		return &ChannelIterator{err: errors.New("query option is nil")}
+	}
+
+	query := *q
+	if query.Limit <= 0 || query.Limit > maxQueryChannelsLimit {
+		query.Limit = maxQueryChannelsLimit
+	}
+
+	return &ChannelIterator{
+		client: c,
+		ctx:    ctx,
+		query:  query,
+		sort:   sort,
+	}
+}
+
+// Next advances the iterator to the next channel, which is then returned by Channel.
+// It returns false when there are no more channels or an error occurred.
+func (it *ChannelIterator) Next() bool {
+	if it.err != nil {
+		return false
+	}
+
+	if len(it.page) == 0 {
+		if it.lastPage {
+			return false
+		}
+		if it.err = it.ctx.Err(); it.err != nil {
+			return false
+		}
+		if it.err = it.fetch(); it.err != nil || len(it.page) == 0 {
+			return false
+		}
+	}
+
+	it.current, it.page = it.page[0], it.page[1:]
+	return true
+}
+
+func (it *ChannelIterator) fetch() error {
+	resp, err := it.client.QueryChannels(it.ctx, &it.query, it.sort...)
+	if err != nil {
+		return err
+	}
+
+	it.page = resp.Channels
+	it.query.Offset += len(resp.Channels)
+	it.lastPage = len(resp.Channels) < it.query.Limit
+	return nil
+}
+
+// Channel returns the current channel.
+func (it *ChannelIterator) Channel() *Channel {
+	return it.current
+}
+
+// Err returns the error which stopped the iteration, if any.
+func (it *ChannelIterator) Err() error {
+	return it.err
+}
+
+// QueryChannelsMetadataOnly returns list of channels that match QueryOption, without their state.
+// Messages, members and reads are not returned, only the channel data such as its CID, custom data and member count.
+// This keeps the payload small when only channel metadata is needed, eg. over thousands of channels.
+// MessageLimit and MemberLimit of the QueryOption are ignored.
+func (c *Client) QueryChannelsMetadataOnly(ctx context.Context, q *QueryOption, sort ...*SortOption) (*QueryChannelsResponse, error) {
+	if q == nil {
+		return nil, errors.New("query option is nil")
+	}
+
+	zero := 0
+	qp := queryRequest{
+		State:            false,
+		FilterConditions: q.Filter,
+		Sort:             sort,
+		UserID:           q.UserID,
+		Limit:            q.Limit,
+		Offset:           q.Offset,
+		MemberLimit:      &zero,
+		MessageLimit:     &zero,
+	}
+
+	return c.queryChannels(ctx, qp)
+}
+
+func (c *Client) queryChannels(ctx context.Context, qp queryRequest) (*QueryChannelsResponse, error) {
 	var resp queryChannelResponse
 	if err := c.makeRequest(ctx, http.MethodPost, "channels", nil, qp, &resp); err != nil {
 		return nil, err
@@ -215,7 +386,12 @@ type QueryMessageFlagsResponse struct {
 }
 
 // QueryMessageFlags returns list of message flags that match QueryOption.
+// Flags can be filtered by channel_cid, user_id and is_reviewed, and paginated with Limit and Offset.
 func (c *Client) QueryMessageFlags(ctx context.Context, q *QueryOption) (*QueryMessageFlagsResponse, error) {
+	if q == nil {
+		return nil, errors.New("query option is nil")
+	}
+
 	qp := queryRequest{
 		FilterConditions: q.Filter,
 		Limit:            q.Limit,
@@ -2,6 +2,7 @@ package stream_chat
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"testing"
 	"time"
@@ -69,6 +70,23 @@ func TestClient_QueryUsers(t *testing.T) {
 	})
 }
 
+func TestClient_QueryUsersWithOptions_Presence(t *testing.T) {
+	c := initClient(t)
+	ctx := context.Background()
+	user := randomUser(t, c)
+
+	_, err := c.QueryUsersWithOptions(ctx, nil)
+	require.Error(t, err)
+
+	resp, err := c.QueryUsersWithOptions(ctx, &QueryUsersOptions{
+		QueryOption: &QueryOption{Filter: Filter{}.Eq("id", user.ID)},
+		Presence:    true,
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.Users, 1)
+	require.False(t, resp.Users[0].Online)
+}
+
 func TestClient_QueryChannels(t *testing.T) {
 	c := initClient(t)
 	ch := initChannel(t, c)
@@ -94,6 +112,68 @@ func TestClient_QueryChannels(t *testing.T) {
 	require.Len(t, resp.Channels[0].Messages, messageLimit)
 }
 
+func TestClient_QueryChannelsAll(t *testing.T) {
+	c := initClient(t)
+	member := randomUser(t, c)
+	ctx := context.Background()
+
+	cids := make([]string, 0, 3)
+	for i := 0; i < 3; i++ {
+		cids = append(cids, initChannel(t, c, member.ID).CID)
+	}
+
+	q := &QueryOption{
+		Filter: Filter{}.In("cid", cids),
+		Limit:  2,
+	}
+
+	got := make([]string, 0, len(cids))
+	it := c.QueryChannelsAll(ctx, q, Asc("created_at"))
+	for it.Next() {
+		got = append(got, it.Channel().CID)
+	}
+	require.NoError(t, it.Err())
+	require.Equal(t, cids, got)
+
+	canceled, cancel := context.WithCancel(ctx)
+	cancel()
+	it = c.QueryChannelsAll(canceled, q)
+	require.False(t, it.Next())
+	require.ErrorIs(t, it.Err(), context.Canceled)
+
+	it = c.QueryChannelsAll(ctx, nil)
+	require.False(t, it.Next())
+	require.Error(t, it.Err())
+}
+
+func TestClient_QueryChannelsMetadataOnly(t *testing.T) {
+	c := initClient(t)
+	user := randomUser(t, c)
+	ch := initChannel(t, c, user.ID)
+	ctx := context.Background()
+
+	_, err := ch.SendMessage(ctx, &Message{Text: "abc"}, user.ID)
+	require.NoError(t, err)
+
+	resp, err := c.QueryChannelsMetadataOnly(ctx, &QueryOption{
+		Filter: map[string]interface{}{
+			"id": map[string]interface{}{
+				"$eq": ch.ID,
+			},
+		},
+	})
+
+	require.NoError(t, err, "query channels error")
+	require.Len(t, resp.Channels, 1)
+	require.Equal(t, ch.CID, resp.Channels[0].CID, "received channel CID")
+	require.Equal(t, 2, resp.Channels[0].MemberCount)
+	require.Empty(t, resp.Channels[0].Messages)
+	require.Empty(t, resp.Channels[0].Members)
+
+	_, err = c.QueryChannelsMetadataOnly(ctx, nil)
+	require.Error(t, err)
+}
+
 func TestClient_Search(t *testing.T) {
 	c := initClient(t)
 	ctx := context.Background()
@@ -297,6 +377,19 @@ func TestClient_QueryMessageFlags(t *testing.T) {
 	})
 	require.NoError(t, err)
 	assert.Len(t, got.Flags, 1)
+
+	// flags are paginated with limit and offset
+	got, err = c.QueryMessageFlags(ctx, &QueryOption{
+		Filter: map[string]interface{}{
+			"channel_cid": map[string][]string{
+				"$in": {ch.cid()},
+			},
+		},
+		Limit:  1,
+		Offset: 1,
+	})
+	require.NoError(t, err)
+	assert.Len(t, got.Flags, 1)
 }
 
 func TestClient_QueryFlagReportsAndReview(t *testing.T) {
@@ -328,3 +421,73 @@ func TestClient_QueryFlagReportsAndReview(t *testing.T) {
 	require.NoError(t, err)
 	require.NotNil(t, flagResp.FlagReport)
 }
+
+func TestClient_ReviewFlag(t *testing.T) {
+	c := initClient(t)
+	ch := initChannel(t, c)
+	ctx := context.Background()
+	user1, user2 := randomUser(t, c), randomUser(t, c)
+	msg, err := ch.SendMessage(ctx, &Message{Text: randomString(25)}, user1.ID)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_, _ = ch.Delete(ctx)
+		_, _ = c.DeleteUser(ctx, user1.ID, DeleteUserWithHardDelete())
+		_, _ = c.DeleteUser(ctx, user2.ID, DeleteUserWithHardDelete())
+	})
+
+	_, err = c.FlagMessage(ctx, msg.Message.ID, user1.ID)
+	require.NoError(t, err)
+
+	resp, err := c.QueryFlagReports(ctx, &QueryFlagReportsRequest{
+		FilterConditions: map[string]interface{}{"message_id": msg.Message.ID},
+	})
+	require.NoError(t, err)
+	require.NotEmpty(t, resp.FlagReports)
+
+	_, err = c.ReviewFlag(ctx, resp.FlagReports[0].ID, ReviewActionMarkReviewed, user2.ID)
+	require.NoError(t, err)
+
+	_, err = c.ReviewFlag(ctx, resp.FlagReports[0].ID, "", user2.ID)
+	require.Error(t, err)
+}
+
+func TestClient_SearchUsers(t *testing.T) {
+	c := initClient(t)
+	ctx := context.Background()
+
+	prefix := randomString(8)
+	for i := 0; i < 3; i++ {
+		u := &User{ID: randomString(10), Name: prefix + " " + randomString(5)}
+		_, err := c.UpsertUser(ctx, u)
+		require.NoError(t, err)
+		t.Cleanup(func() {
+			_, _ = c.DeleteUsers(ctx, []string{u.ID}, DeleteUserOptions{User: HardDelete, Messages: HardDelete})
+		})
+	}
+
+	users, err := c.SearchUsers(ctx, prefix, 2)
+	require.NoError(t, err)
+	require.Len(t, users, 2)
+	for _, u := range users {
+		require.Contains(t, u.Name, prefix)
+	}
+
+	_, err = c.SearchUsers(ctx, "", 2)
+	require.Error(t, err)
+}
+
+func TestSortOption_AscDesc(t *testing.T) {
+	qp := queryRequest{Sort: []*SortOption{Desc("last_message_at"), Asc("created_at")}}
+
+	data, err := json.Marshal(qp)
+	require.NoError(t, err)
+
+	var got struct {
+		Sort []SortOption `json:"sort"`
+	}
+	require.NoError(t, json.Unmarshal(data, &got))
+	require.Equal(t, []SortOption{
+		{Field: "last_message_at", Direction: -1},
+		{Field: "created_at", Direction: 1},
+	}, got.Sort, "sort options are sent in order")
+}
@@ -2,6 +2,8 @@ package stream_chat
 
 import (
 	"context"
+	"fmt"
+	"log"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -51,3 +53,21 @@ func TestClient_GetRateLimits(t *testing.T) {
 		require.Greater(t, limits.ServerSide["GetRateLimits"].Limit, limits.ServerSide["GetRateLimits"].Remaining)
 	})
 }
+
+// Reports the current quota usage of a few server-side endpoints.
+func ExampleClient_GetRateLimits() {
+	client, err := NewClient("XXXX", "XXXX")
+	if err != nil {
+		log.Fatalf("Err: %v", err)
+	}
+	ctx := context.Background()
+
+	limits, err := client.GetRateLimits(ctx, WithServerSide(), WithEndpoints("SendMessage", "QueryChannels"))
+	if err != nil {
+		log.Fatalf("Err: %v", err)
+	}
+
+	for endpoint, limit := range limits.ServerSide {
+		fmt.Printf("%s: %d/%d used, resets at %s\n", endpoint, limit.Limit-limit.Remaining, limit.Limit, limit.ResetTime())
+	}
+}
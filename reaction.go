@@ -4,15 +4,24 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"net/http"
 	"net/url"
 	"path"
+	"time"
 )
 
 type Reaction struct {
 	MessageID string `json:"message_id"`
 	UserID    string `json:"user_id"`
 	Type      string `json:"type"`
+	Score     int    `json:"score,omitempty"` // weight of the reaction, e.g. the number of claps
+
+	// User is the full user object of the reacting user, populated on reads.
+	User *User `json:"user,omitempty"`
+
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+	UpdatedAt *time.Time `json:"updated_at,omitempty"`
 
 	// any other fields the user wants to attach a reaction
 	ExtraData map[string]interface{} `json:"-"`
@@ -46,23 +55,41 @@ type ReactionResponse struct {
 }
 
 type reactionRequest struct {
-	Reaction *Reaction `json:"reaction"`
+	Reaction      *Reaction `json:"reaction"`
+	EnforceUnique bool      `json:"enforce_unique,omitempty"`
+	SkipPush      bool      `json:"skip_push,omitempty"`
+}
+
+type SendReactionOption func(*reactionRequest)
+
+// ReactionEnforceUnique makes the reaction replace all previous reactions of the user on the message.
+func ReactionEnforceUnique(r *reactionRequest) {
+	if r != nil {
+		r.EnforceUnique = true
+	}
+}
+
+// ReactionSkipPush prevents the reaction from triggering push notifications.
+func ReactionSkipPush(r *reactionRequest) {
+	if r != nil {
+		r.SkipPush = true
+	}
 }
 
 // SendReaction sends a reaction to message with given ID.
 // Deprecated: SendReaction is deprecated, use client.SendReaction instead.
-func (ch *Channel) SendReaction(ctx context.Context, reaction *Reaction, messageID, userID string) (*ReactionResponse, error) {
-	return ch.client.SendReaction(ctx, reaction, messageID, userID)
+func (ch *Channel) SendReaction(ctx context.Context, reaction *Reaction, messageID, userID string, options ...SendReactionOption) (*ReactionResponse, error) {
+	return ch.client.SendReaction(ctx, reaction, messageID, userID, options...)
 }
 
 // DeleteReaction removes a reaction from message with given ID.
 // Deprecated: DeleteReaction is deprecated, use client.DeleteReaction instead.
-func (ch *Channel) DeleteReaction(ctx context.Context, messageID, reactionType, userID string) (*ReactionResponse, error) {
-	return ch.client.DeleteReaction(ctx, messageID, reactionType, userID)
+func (ch *Channel) DeleteReaction(ctx context.Context, messageID, reactionType, userID string, options ...DeleteReactionOption) (*ReactionResponse, error) {
+	return ch.client.DeleteReaction(ctx, messageID, reactionType, userID, options...)
 }
 
-// SendReaction sends a reaction to message with given ID.
-func (c *Client) SendReaction(ctx context.Context, reaction *Reaction, messageID, userID string) (*ReactionResponse, error) {
+// SendReaction sends a reaction to message with given ID and returns the updated message.
+func (c *Client) SendReaction(ctx context.Context, reaction *Reaction, messageID, userID string, options ...SendReactionOption) (*ReactionResponse, error) {
 	switch {
 	case reaction == nil:
 		return nil, errors.New("reaction is nil")
@@ -76,14 +103,42 @@ func (c *Client) SendReaction(ctx context.Context, reaction *Reaction, messageID
 	p := path.Join("messages", url.PathEscape(messageID), "reaction")
 
 	req := reactionRequest{Reaction: reaction}
+	for _, op := range options {
+		op(&req)
+	}
 
 	var resp ReactionResponse
 	err := c.makeRequest(ctx, http.MethodPost, p, nil, req, &resp)
 	return &resp, err
 }
 
-// DeleteReaction removes a reaction from message with given ID.
-func (c *Client) DeleteReaction(ctx context.Context, messageID, reactionType, userID string) (*ReactionResponse, error) {
+type deleteReactionRequest struct {
+	SkipPush       bool
+	DecrementScore bool
+}
+
+type DeleteReactionOption func(*deleteReactionRequest)
+
+// DeleteReactionSkipPush prevents the reaction deletion from triggering push notifications.
+func DeleteReactionSkipPush(r *deleteReactionRequest) {
+	if r != nil {
+		r.SkipPush = true
+	}
+}
+
+// DeleteReactionDecrementScore lowers the score of the reaction by one instead of removing it,
+// e.g. to take back a single clap. The reaction is removed when its score would drop to zero.
+// The reaction is read and then sent again with the lower score, which isn't atomic: concurrent
+// updates of the same reaction may be lost. While the reaction is kept, reaction.updated or
+// reaction.new events are sent instead of reaction.deleted.
+func DeleteReactionDecrementScore(r *deleteReactionRequest) {
+	if r != nil {
+		r.DecrementScore = true
+	}
+}
+
+// DeleteReaction removes a reaction from message with given ID and returns the updated message.
+func (c *Client) DeleteReaction(ctx context.Context, messageID, reactionType, userID string, options ...DeleteReactionOption) (*ReactionResponse, error) {
 	switch {
 	case messageID == "":
 		return nil, errors.New("message ID is empty")
@@ -93,10 +148,25 @@ func (c *Client) DeleteReaction(ctx context.Context, messageID, reactionType, us
 		return nil, errors.New("user ID is empty")
 	}
 
+	var req deleteReactionRequest
+	for _, op := range options {
+		op(&req)
+	}
+
+	if req.DecrementScore {
+		return c.decrementReaction(ctx, messageID, reactionType, userID, req.SkipPush)
+	}
+	return c.deleteReaction(ctx, messageID, reactionType, userID, req.SkipPush)
+}
+
+func (c *Client) deleteReaction(ctx context.Context, messageID, reactionType, userID string, skipPush bool) (*ReactionResponse, error) {
 	p := path.Join("messages", url.PathEscape(messageID), "reaction", url.PathEscape(reactionType))
 
 	params := url.Values{}
 	params.Set("user_id", userID)
+	if skipPush {
+		params.Set("skip_push", "true")
+	}
 
 	var resp ReactionResponse
 	err := c.makeRequest(ctx, http.MethodDelete, p, params, nil, &resp)
@@ -110,6 +180,41 @@ func (c *Client) DeleteReaction(ctx context.Context, messageID, reactionType, us
 	return &resp, nil
 }
 
+// decrementReaction lowers the score of the user's reaction by one, or removes it if its score is one.
+// The score is read and written in separate requests, see DeleteReactionDecrementScore.
+func (c *Client) decrementReaction(ctx context.Context, messageID, reactionType, userID string, skipPush bool) (*ReactionResponse, error) {
+	reactions, err := c.QueryReactions(ctx, messageID, &QueryReactionsRequest{
+		Filter: map[string]interface{}{
+			"user_id": userID,
+			"type":    reactionType,
+		},
+		Limit: 1,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(reactions.Reactions) == 0 {
+		return nil, fmt.Errorf("reaction %q of user %q not found", reactionType, userID)
+	}
+
+	current := reactions.Reactions[0]
+	if current.Score <= 1 {
+		return c.deleteReaction(ctx, messageID, reactionType, userID, skipPush)
+	}
+
+	reaction := &Reaction{
+		Type:      reactionType,
+		Score:     current.Score - 1,
+		ExtraData: current.ExtraData,
+	}
+
+	var options []SendReactionOption
+	if skipPush {
+		options = append(options, ReactionSkipPush)
+	}
+	return c.SendReaction(ctx, reaction, messageID, userID, options...)
+}
+
 type ReactionsResponse struct {
 	Reactions []*Reaction `json:"reactions"`
 	Response
@@ -128,3 +233,36 @@ func (c *Client) GetReactions(ctx context.Context, messageID string, options map
 	err := c.makeRequest(ctx, http.MethodGet, p, options, nil, &resp)
 	return &resp, err
 }
+
+type QueryReactionsRequest struct {
+	Filter map[string]interface{} `json:"filter,omitempty"`
+	Sort   []*SortOption          `json:"sort,omitempty"`
+	UserID string                 `json:"user_id,omitempty"`
+	Limit  int                    `json:"limit,omitempty"`
+	Next   string                 `json:"next,omitempty"`
+	Prev   string                 `json:"prev,omitempty"`
+}
+
+type QueryReactionsResponse struct {
+	Reactions []*Reaction `json:"reactions"`
+	Next      string      `json:"next,omitempty"`
+	Prev      string      `json:"prev,omitempty"`
+	Response
+}
+
+// QueryReactions returns the reactions of the message with given ID matching the request.
+// Results are paginated with a cursor: pass Next from the response to the next request.
+func (c *Client) QueryReactions(ctx context.Context, messageID string, q *QueryReactionsRequest) (*QueryReactionsResponse, error) {
+	if messageID == "" {
+		return nil, errors.New("message ID is empty")
+	}
+	if q == nil {
+		q = &QueryReactionsRequest{}
+	}
+
+	p := path.Join("messages", url.PathEscape(messageID), "reactions")
+
+	var resp QueryReactionsResponse
+	err := c.makeRequest(ctx, http.MethodPost, p, nil, q, &resp)
+	return &resp, err
+}
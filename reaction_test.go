@@ -1,8 +1,11 @@
 package stream_chat
 
 import (
+	"bytes"
 	"context"
+	"io"
 	"log"
+	"net/http"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -58,6 +61,26 @@ func reactionExistsCondition(reactions []*Reaction, searchType string) func() bo
 	}
 }
 
+func TestClient_SendReaction_ScoreAndEnforceUnique(t *testing.T) {
+	c := initClient(t)
+	user := randomUser(t, c)
+	ch := initChannel(t, c, user.ID)
+	ctx := context.Background()
+
+	resp, err := ch.SendMessage(ctx, &Message{Text: "test message"}, user.ID)
+	require.NoError(t, err)
+	msgID := resp.Message.ID
+
+	reactionResp, err := c.SendReaction(ctx, &Reaction{Type: "clap", Score: 5}, msgID, user.ID)
+	require.NoError(t, err)
+	require.Equal(t, 5, reactionResp.Reaction.Score)
+	require.Equal(t, 5, reactionResp.Message.ReactionScores["clap"])
+
+	reactionResp, err = c.SendReaction(ctx, &Reaction{Type: "love"}, msgID, user.ID, ReactionEnforceUnique, ReactionSkipPush)
+	require.NoError(t, err)
+	require.Equal(t, map[string]int{"love": 1}, reactionResp.Message.ReactionCounts)
+}
+
 func TestClient_DeleteReaction(t *testing.T) {
 	c := initClient(t)
 	ch := initChannel(t, c)
@@ -82,6 +105,59 @@ func TestClient_DeleteReaction(t *testing.T) {
 	assert.Empty(t, reactionResp.Message.LatestReactions, "latest reactions empty")
 }
 
+func TestClient_DeleteReaction_DecrementScore(t *testing.T) {
+	c := initClient(t)
+	user := randomUser(t, c)
+	ch := initChannel(t, c, user.ID)
+	ctx := context.Background()
+
+	resp, err := ch.SendMessage(ctx, &Message{Text: "test message"}, user.ID)
+	require.NoError(t, err)
+	msgID := resp.Message.ID
+
+	_, err = c.SendReaction(ctx, &Reaction{Type: "clap", Score: 2}, msgID, user.ID)
+	require.NoError(t, err)
+
+	recorder := &bodyRecorder{next: c.HTTP.Transport}
+	c.HTTP.Transport = recorder
+	defer func() { c.HTTP.Transport = recorder.next }()
+
+	reactionResp, err := c.DeleteReaction(ctx, msgID, "clap", user.ID, DeleteReactionDecrementScore, DeleteReactionSkipPush)
+	require.NoError(t, err)
+	require.Equal(t, 1, reactionResp.Message.ReactionScores["clap"])
+	require.Equal(t, 1, reactionResp.Message.ReactionCounts["clap"])
+
+	sent, ok := recorder.bodies["POST /messages/"+msgID+"/reaction"]
+	require.True(t, ok, "the lower score is sent as a reaction")
+	require.Contains(t, sent, `"skip_push":true`)
+
+	reactionResp, err = c.DeleteReaction(ctx, msgID, "clap", user.ID, DeleteReactionDecrementScore)
+	require.NoError(t, err)
+	require.Zero(t, reactionResp.Message.ReactionCounts["clap"])
+}
+
+// bodyRecorder records the bodies of the requests by method and path.
+type bodyRecorder struct {
+	next   http.RoundTripper
+	bodies map[string]string
+}
+
+func (r *bodyRecorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Body != nil {
+		body, err := io.ReadAll(req.Body)
+		if err != nil {
+			return nil, err
+		}
+		req.Body = io.NopCloser(bytes.NewReader(body))
+
+		if r.bodies == nil {
+			r.bodies = make(map[string]string)
+		}
+		r.bodies[req.Method+" "+req.URL.Path] = string(body)
+	}
+	return r.next.RoundTrip(req)
+}
+
 func TestClient_GetReactions(t *testing.T) {
 	c := initClient(t)
 	ch := initChannel(t, c)
@@ -109,4 +185,42 @@ func TestClient_GetReactions(t *testing.T) {
 	require.NoError(t, err, "get reactions")
 
 	assert.Condition(t, reactionExistsCondition(reactionsResp.Reactions, reaction.Type), "reaction exists")
+
+	require.Len(t, reactionsResp.Reactions, 1)
+	require.NotNil(t, reactionsResp.Reactions[0].User, "reaction user is hydrated")
+	assert.Equal(t, user.ID, reactionsResp.Reactions[0].User.ID)
+}
+
+func TestClient_QueryReactions(t *testing.T) {
+	c := initClient(t)
+	users := []*User{randomUser(t, c), randomUser(t, c), randomUser(t, c)}
+	ch := initChannel(t, c, users[0].ID, users[1].ID, users[2].ID)
+	ctx := context.Background()
+
+	resp, err := ch.SendMessage(ctx, &Message{Text: "test message"}, users[0].ID)
+	require.NoError(t, err)
+	msgID := resp.Message.ID
+
+	for _, u := range users {
+		_, err = c.SendReaction(ctx, &Reaction{Type: "love"}, msgID, u.ID)
+		require.NoError(t, err)
+	}
+
+	seen := make(map[string]bool)
+	q := &QueryReactionsRequest{
+		Filter: map[string]interface{}{"type": "love"},
+		Limit:  2,
+	}
+	for {
+		page, err := c.QueryReactions(ctx, msgID, q)
+		require.NoError(t, err)
+		for _, r := range page.Reactions {
+			seen[r.UserID] = true
+		}
+		if page.Next == "" {
+			break
+		}
+		q.Next = page.Next
+	}
+	require.Len(t, seen, len(users))
 }
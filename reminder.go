@@ -0,0 +1,112 @@
+package stream_chat
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"path"
+	"time"
+)
+
+// Reminder is a reminder set by a user on a message.
+// A reminder without RemindAt is a "save for later" bookmark.
+type Reminder struct {
+	ChannelCID string     `json:"channel_cid"`
+	MessageID  string     `json:"message_id"`
+	UserID     string     `json:"user_id"`
+	RemindAt   *time.Time `json:"remind_at,omitempty"`
+
+	Message *Message `json:"message,omitempty"`
+	User    *User    `json:"user,omitempty"`
+	Channel *Channel `json:"channel,omitempty"`
+
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+type ReminderResponse struct {
+	Reminder *Reminder `json:"reminder"`
+	Response
+}
+
+type reminderRequest struct {
+	UserID   string     `json:"user_id"`
+	RemindAt *time.Time `json:"remind_at"`
+}
+
+// CreateReminder creates a reminder on the message with given msgID for the user with given ID.
+// remindAt is optional, a reminder without it acts as a bookmark.
+func (c *Client) CreateReminder(ctx context.Context, msgID, userID string, remindAt *time.Time) (*ReminderResponse, error) {
+	return c.saveReminder(ctx, http.MethodPost, msgID, userID, remindAt)
+}
+
+// UpdateReminder updates the time of the reminder on the message with given msgID for the user with given ID.
+// Passing a nil remindAt turns the reminder into a bookmark.
+func (c *Client) UpdateReminder(ctx context.Context, msgID, userID string, remindAt *time.Time) (*ReminderResponse, error) {
+	return c.saveReminder(ctx, http.MethodPatch, msgID, userID, remindAt)
+}
+
+func (c *Client) saveReminder(ctx context.Context, method, msgID, userID string, remindAt *time.Time) (*ReminderResponse, error) {
+	switch {
+	case msgID == "":
+		return nil, errors.New("message ID must be not empty")
+	case userID == "":
+		return nil, errors.New("user ID must be not empty")
+	}
+
+	p := path.Join("messages", url.PathEscape(msgID), "reminders")
+
+	var resp ReminderResponse
+	err := c.makeRequest(ctx, method, p, nil, reminderRequest{UserID: userID, RemindAt: remindAt}, &resp)
+	return &resp, err
+}
+
+// DeleteReminder deletes the reminder on the message with given msgID for the user with given ID.
+func (c *Client) DeleteReminder(ctx context.Context, msgID, userID string) (*Response, error) {
+	switch {
+	case msgID == "":
+		return nil, errors.New("message ID must be not empty")
+	case userID == "":
+		return nil, errors.New("user ID must be not empty")
+	}
+
+	p := path.Join("messages", url.PathEscape(msgID), "reminders")
+
+	params := url.Values{}
+	params.Set("user_id", userID)
+
+	var resp Response
+	err := c.makeRequest(ctx, http.MethodDelete, p, params, nil, &resp)
+	return &resp, err
+}
+
+type QueryRemindersRequest struct {
+	UserID string                 `json:"user_id"`
+	Filter map[string]interface{} `json:"filter,omitempty"`
+	Sort   []*SortOption          `json:"sort,omitempty"`
+	Limit  int                    `json:"limit,omitempty"`
+	Next   string                 `json:"next,omitempty"`
+	Prev   string                 `json:"prev,omitempty"`
+}
+
+type QueryRemindersResponse struct {
+	Reminders []*Reminder `json:"reminders"`
+	Next      string      `json:"next,omitempty"`
+	Prev      string      `json:"prev,omitempty"`
+	Response
+}
+
+// QueryReminders returns the reminders of a user matching the given filter.
+func (c *Client) QueryReminders(ctx context.Context, req *QueryRemindersRequest) (*QueryRemindersResponse, error) {
+	switch {
+	case req == nil:
+		return nil, errors.New("request is nil")
+	case req.UserID == "":
+		return nil, errors.New("user ID must be not empty")
+	}
+
+	var resp QueryRemindersResponse
+	err := c.makeRequest(ctx, http.MethodPost, "reminders/query", nil, req, &resp)
+	return &resp, err
+}
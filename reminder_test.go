@@ -0,0 +1,51 @@
+package stream_chat
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_Reminders(t *testing.T) {
+	c := initClient(t)
+	user := randomUser(t, c)
+	ch := initChannel(t, c, user.ID)
+	ctx := context.Background()
+
+	msgResp, err := ch.SendMessage(ctx, &Message{Text: "remind me"}, user.ID, MessageSkipPush)
+	require.NoError(t, err)
+	msgID := msgResp.Message.ID
+
+	// a bookmark, without remind at
+	resp, err := c.CreateReminder(ctx, msgID, user.ID, nil)
+	require.NoError(t, err)
+	require.Equal(t, msgID, resp.Reminder.MessageID)
+	require.Equal(t, user.ID, resp.Reminder.UserID)
+	require.Nil(t, resp.Reminder.RemindAt)
+
+	remindAt := time.Now().Add(time.Hour).UTC().Truncate(time.Second)
+	resp, err = c.UpdateReminder(ctx, msgID, user.ID, &remindAt)
+	require.NoError(t, err)
+	require.NotNil(t, resp.Reminder.RemindAt)
+	require.True(t, remindAt.Equal(*resp.Reminder.RemindAt))
+
+	queryResp, err := c.QueryReminders(ctx, &QueryRemindersRequest{
+		UserID: user.ID,
+		Filter: map[string]interface{}{"channel_cid": ch.CID},
+	})
+	require.NoError(t, err)
+	require.Len(t, queryResp.Reminders, 1)
+	require.Equal(t, msgID, queryResp.Reminders[0].MessageID)
+
+	_, err = c.DeleteReminder(ctx, msgID, user.ID)
+	require.NoError(t, err)
+
+	queryResp, err = c.QueryReminders(ctx, &QueryRemindersRequest{
+		UserID: user.ID,
+		Filter: map[string]interface{}{"channel_cid": ch.CID},
+	})
+	require.NoError(t, err)
+	require.Empty(t, queryResp.Reminders)
+}
@@ -0,0 +1,121 @@
+package stream_chat
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"path"
+	"time"
+)
+
+type SegmentType string
+
+const (
+	SegmentTypeUser    SegmentType = "user"
+	SegmentTypeChannel SegmentType = "channel"
+)
+
+// Segment is a group of users or channels targeted by a campaign.
+// Its targets are the ones matching Filter, or the ones added with AddSegmentTargets.
+type Segment struct {
+	ID          string                 `json:"id,omitempty"`
+	Type        SegmentType            `json:"type"`
+	Name        string                 `json:"name"`
+	Description string                 `json:"description,omitempty"`
+	Filter      map[string]interface{} `json:"filter,omitempty"`
+	AllUsers    bool                   `json:"all_users,omitempty"`
+	Size        int                    `json:"size,omitempty"`
+
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+	UpdatedAt *time.Time `json:"updated_at,omitempty"`
+}
+
+type SegmentResponse struct {
+	Segment *Segment `json:"segment"`
+	Response
+}
+
+// CreateSegment creates a segment and returns it.
+func (c *Client) CreateSegment(ctx context.Context, segment *Segment) (*SegmentResponse, error) {
+	switch {
+	case segment == nil:
+		return nil, errors.New("segment is nil")
+	case segment.Type == "":
+		return nil, errors.New("segment type must be not empty")
+	case segment.Name == "":
+		return nil, errors.New("segment name must be not empty")
+	}
+
+	var resp SegmentResponse
+	err := c.makeRequest(ctx, http.MethodPost, "segments", nil, segment, &resp)
+	return &resp, err
+}
+
+// GetSegment returns the segment with given ID.
+func (c *Client) GetSegment(ctx context.Context, id string) (*SegmentResponse, error) {
+	if id == "" {
+		return nil, errors.New("segment ID must be not empty")
+	}
+
+	p := path.Join("segments", url.PathEscape(id))
+
+	var resp SegmentResponse
+	err := c.makeRequest(ctx, http.MethodGet, p, nil, nil, &resp)
+	return &resp, err
+}
+
+// DeleteSegment deletes the segment with given ID.
+func (c *Client) DeleteSegment(ctx context.Context, id string) (*Response, error) {
+	if id == "" {
+		return nil, errors.New("segment ID must be not empty")
+	}
+
+	p := path.Join("segments", url.PathEscape(id))
+
+	var resp Response
+	err := c.makeRequest(ctx, http.MethodDelete, p, nil, nil, &resp)
+	return &resp, err
+}
+
+// AddSegmentTargets adds the users or channels with given IDs to the segment with given ID.
+func (c *Client) AddSegmentTargets(ctx context.Context, id string, targetIDs []string) (*Response, error) {
+	switch {
+	case id == "":
+		return nil, errors.New("segment ID must be not empty")
+	case len(targetIDs) == 0:
+		return nil, errors.New("target IDs are empty")
+	}
+
+	p := path.Join("segments", url.PathEscape(id), "addtargets")
+
+	var resp Response
+	err := c.makeRequest(ctx, http.MethodPost, p, nil, map[string]interface{}{"target_ids": targetIDs}, &resp)
+	return &resp, err
+}
+
+type QuerySegmentsRequest struct {
+	Filter map[string]interface{} `json:"filter"`
+	Sort   []*SortOption          `json:"sort,omitempty"`
+	Limit  int                    `json:"limit,omitempty"`
+	Next   string                 `json:"next,omitempty"`
+	Prev   string                 `json:"prev,omitempty"`
+}
+
+type QuerySegmentsResponse struct {
+	Segments []*Segment `json:"segments"`
+	Next     string     `json:"next,omitempty"`
+	Prev     string     `json:"prev,omitempty"`
+	Response
+}
+
+// QuerySegments returns the segments matching the given filter.
+func (c *Client) QuerySegments(ctx context.Context, req *QuerySegmentsRequest) (*QuerySegmentsResponse, error) {
+	if req == nil {
+		return nil, errors.New("request is nil")
+	}
+
+	var resp QuerySegmentsResponse
+	err := c.makeRequest(ctx, http.MethodPost, "segments/query", nil, req, &resp)
+	return &resp, err
+}
@@ -0,0 +1,49 @@
+package stream_chat
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func prepareSegment(t *testing.T, c *Client, targetIDs ...string) *Segment {
+	t.Helper()
+	ctx := context.Background()
+
+	resp, err := c.CreateSegment(ctx, &Segment{Type: SegmentTypeUser, Name: randomString(10)})
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_, _ = c.DeleteSegment(ctx, resp.Segment.ID)
+	})
+
+	if len(targetIDs) > 0 {
+		_, err = c.AddSegmentTargets(ctx, resp.Segment.ID, targetIDs)
+		require.NoError(t, err)
+	}
+	return resp.Segment
+}
+
+func TestClient_Segments(t *testing.T) {
+	c := initClient(t)
+	ctx := context.Background()
+	user := randomUser(t, c)
+
+	segment := prepareSegment(t, c, user.ID)
+
+	got, err := c.GetSegment(ctx, segment.ID)
+	require.NoError(t, err)
+	require.Equal(t, segment.Name, got.Segment.Name)
+
+	query, err := c.QuerySegments(ctx, &QuerySegmentsRequest{
+		Filter: map[string]interface{}{"id": segment.ID},
+	})
+	require.NoError(t, err)
+	require.Len(t, query.Segments, 1)
+
+	_, err = c.DeleteSegment(ctx, segment.ID)
+	require.NoError(t, err)
+
+	_, err = c.CreateSegment(ctx, &Segment{Type: SegmentTypeUser})
+	require.Error(t, err)
+}
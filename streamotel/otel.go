@@ -0,0 +1,61 @@
+// Package streamotel traces the API requests of a stream_chat.Client with OpenTelemetry.
+// It is a separate module, so the client itself does not depend on OpenTelemetry.
+package streamotel
+
+import (
+	"context"
+	"fmt"
+
+	stream "github.com/GetStream/stream-chat-go/v6"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// instrumentationName is the name of the tracer created from the TracerProvider.
+const instrumentationName = "github.com/GetStream/stream-chat-go/v6/streamotel"
+
+// WithTracerProvider creates an OpenTelemetry span for every API request of the client,
+// with a tracer from tp. The spans are children of the span in the context of the API call.
+func WithTracerProvider(tp trace.TracerProvider) stream.ClientOption {
+	return stream.WithTracer(tracer{t: tp.Tracer(instrumentationName)})
+}
+
+type tracer struct {
+	t trace.Tracer
+}
+
+func (t tracer) Start(ctx context.Context, spanName string) (context.Context, stream.Span) {
+	ctx, s := t.t.Start(ctx, spanName, trace.WithSpanKind(trace.SpanKindClient))
+	return ctx, span{s: s}
+}
+
+type span struct {
+	s trace.Span
+}
+
+func (s span) SetAttributes(attrs map[string]interface{}) {
+	kvs := make([]attribute.KeyValue, 0, len(attrs))
+	for k, v := range attrs {
+		switch v := v.(type) {
+		case string:
+			kvs = append(kvs, attribute.String(k, v))
+		case int:
+			kvs = append(kvs, attribute.Int(k, v))
+		case bool:
+			kvs = append(kvs, attribute.Bool(k, v))
+		default:
+			kvs = append(kvs, attribute.String(k, fmt.Sprint(v)))
+		}
+	}
+	s.s.SetAttributes(kvs...)
+}
+
+func (s span) RecordError(err error) {
+	s.s.RecordError(err)
+	s.s.SetStatus(codes.Error, err.Error())
+}
+
+func (s span) End() {
+	s.s.End()
+}
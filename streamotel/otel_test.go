@@ -0,0 +1,59 @@
+package streamotel
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	stream "github.com/GetStream/stream-chat-go/v6"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func TestWithTracerProvider(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/app" {
+			_, _ = w.Write([]byte(`{"app":{}}`))
+			return
+		}
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"code":4,"message":"not found","StatusCode":404}`))
+	}))
+	defer srv.Close()
+
+	recorder := tracetest.NewSpanRecorder()
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+
+	c, err := stream.NewClient("key", "secret", WithTracerProvider(tp))
+	require.NoError(t, err)
+	c.BaseURL = srv.URL
+
+	ctx, parent := tp.Tracer("test").Start(context.Background(), "handler")
+	_, err = c.GetAppSettings(ctx)
+	require.NoError(t, err)
+	_, err = c.GetMessage(ctx, "missing")
+	require.Error(t, err)
+	parent.End()
+
+	spans := recorder.Ended()
+	require.Len(t, spans, 3)
+
+	ok, failed := spans[0], spans[1]
+	require.Equal(t, stream.RequestSpanName, ok.Name())
+	require.Equal(t, parent.SpanContext().SpanID(), ok.Parent().SpanID())
+	require.Equal(t, codes.Unset, ok.Status().Code)
+
+	require.Equal(t, stream.RequestSpanName, failed.Name())
+	require.Equal(t, codes.Error, failed.Status().Code)
+	attrs := map[string]interface{}{}
+	for _, kv := range failed.Attributes() {
+		attrs[string(kv.Key)] = kv.Value.AsInterface()
+	}
+	require.Equal(t, "GET", attrs[stream.SpanAttrHTTPMethod])
+	require.Equal(t, "messages/missing", attrs[stream.SpanAttrURLPath])
+	require.EqualValues(t, http.StatusNotFound, attrs[stream.SpanAttrHTTPStatusCode])
+	require.EqualValues(t, 4, attrs[stream.SpanAttrErrorCode])
+}
@@ -0,0 +1,113 @@
+package stream_chat
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"path"
+	"time"
+)
+
+type ThreadParticipant struct {
+	ChannelCID   string     `json:"channel_cid"`
+	ThreadID     string     `json:"thread_id"`
+	UserID       string     `json:"user_id"`
+	User         *User      `json:"user,omitempty"`
+	CreatedAt    time.Time  `json:"created_at"`
+	LastThreadAt *time.Time `json:"last_thread_at,omitempty"`
+	LastReadAt   time.Time  `json:"last_read_at"`
+	LeftThreadAt *time.Time `json:"left_thread_at,omitempty"`
+}
+
+// ThreadRead is the read state of a user in a thread.
+type ThreadRead struct {
+	User              *User     `json:"user"`
+	LastRead          time.Time `json:"last_read"`
+	LastReadMessageID string    `json:"last_read_message_id,omitempty"`
+	UnreadMessages    int       `json:"unread_messages"`
+}
+
+type Thread struct {
+	ChannelCID      string   `json:"channel_cid"`
+	Channel         *Channel `json:"channel,omitempty"`
+	ParentMessageID string   `json:"parent_message_id"`
+	ParentMessage   *Message `json:"parent_message,omitempty"`
+	CreatedByUserID string   `json:"created_by_user_id"`
+	CreatedBy       *User    `json:"created_by,omitempty"`
+	Title           string   `json:"title,omitempty"`
+
+	ReplyCount       int                  `json:"reply_count"`
+	ParticipantCount int                  `json:"participant_count"`
+	Participants     []*ThreadParticipant `json:"thread_participants,omitempty"`
+	LatestReplies    []*Message           `json:"latest_replies,omitempty"`
+	Read             []*ThreadRead        `json:"read,omitempty"` // read state, includes the requesting user's
+
+	LastMessageAt *time.Time `json:"last_message_at,omitempty"`
+	CreatedAt     time.Time  `json:"created_at"`
+	UpdatedAt     time.Time  `json:"updated_at"`
+	DeletedAt     *time.Time `json:"deleted_at,omitempty"`
+
+	Custom map[string]interface{} `json:"custom,omitempty"`
+}
+
+type QueryThreadsRequest struct {
+	Filter map[string]interface{} `json:"filter,omitempty"`
+	Sort   []*SortOption          `json:"sort,omitempty"`
+
+	// UserID is the user whose threads and read state are returned.
+	UserID string `json:"user_id,omitempty"`
+
+	Limit            int  `json:"limit,omitempty"`
+	ReplyLimit       *int `json:"reply_limit,omitempty"`
+	ParticipantLimit *int `json:"participant_limit,omitempty"`
+	MemberLimit      *int `json:"member_limit,omitempty"`
+
+	Next string `json:"next,omitempty"`
+	Prev string `json:"prev,omitempty"`
+}
+
+type QueryThreadsResponse struct {
+	Threads []*Thread `json:"threads"`
+	Next    string    `json:"next,omitempty"`
+	Prev    string    `json:"prev,omitempty"`
+	Response
+}
+
+// QueryThreads returns the threads matching the request.
+// Pass Next from the response to the next request to fetch the next page.
+func (c *Client) QueryThreads(ctx context.Context, req QueryThreadsRequest) (*QueryThreadsResponse, error) {
+	var resp QueryThreadsResponse
+	err := c.makeRequest(ctx, http.MethodPost, "threads", nil, req, &resp)
+	return &resp, err
+}
+
+type ThreadResponse struct {
+	Thread *Thread `json:"thread"`
+	Response
+}
+
+// PartialUpdateThread sets and unsets fields of the thread with the given parent message ID
+// on behalf of the user with given ID. Custom fields are set at the top level, e.g. "title".
+func (c *Client) PartialUpdateThread(ctx context.Context, messageID string, set map[string]interface{}, unset []string, userID string) (*ThreadResponse, error) {
+	switch {
+	case messageID == "":
+		return nil, errors.New("message ID must be not empty")
+	case len(set) == 0 && len(unset) == 0:
+		return nil, errors.New("set or unset should not be empty")
+	}
+
+	p := path.Join("threads", url.PathEscape(messageID))
+
+	data := map[string]interface{}{
+		"set":   set,
+		"unset": unset,
+	}
+	if userID != "" {
+		data["user_id"] = userID
+	}
+
+	var resp ThreadResponse
+	err := c.makeRequest(ctx, http.MethodPatch, p, nil, data, &resp)
+	return &resp, err
+}
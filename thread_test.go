@@ -0,0 +1,73 @@
+package stream_chat
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_QueryThreads(t *testing.T) {
+	c := initClient(t)
+	user := randomUser(t, c)
+	ch := initChannel(t, c, user.ID)
+	ctx := context.Background()
+
+	parentIDs := make([]string, 0, 2)
+	for i := 0; i < 2; i++ {
+		parent, err := ch.SendMessage(ctx, &Message{Text: "parent"}, user.ID)
+		require.NoError(t, err)
+		_, err = ch.SendMessage(ctx, &Message{Text: "reply", ParentID: parent.Message.ID}, user.ID)
+		require.NoError(t, err)
+		parentIDs = append(parentIDs, parent.Message.ID)
+	}
+
+	resp, err := c.QueryThreads(ctx, QueryThreadsRequest{
+		Filter: map[string]interface{}{"channel_cid": ch.CID},
+		UserID: user.ID,
+		Limit:  1,
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.Threads, 1)
+	require.NotEmpty(t, resp.Next)
+
+	thread := resp.Threads[0]
+	require.Contains(t, parentIDs, thread.ParentMessageID)
+	require.Equal(t, 1, thread.ReplyCount)
+	require.NotNil(t, thread.LastMessageAt)
+	require.NotEmpty(t, thread.Participants)
+
+	resp, err = c.QueryThreads(ctx, QueryThreadsRequest{
+		Filter: map[string]interface{}{"channel_cid": ch.CID},
+		UserID: user.ID,
+		Limit:  1,
+		Next:   resp.Next,
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.Threads, 1)
+	require.NotEqual(t, thread.ParentMessageID, resp.Threads[0].ParentMessageID)
+}
+
+func TestClient_PartialUpdateThread(t *testing.T) {
+	c := initClient(t)
+	user := randomUser(t, c)
+	ch := initChannel(t, c, user.ID)
+	ctx := context.Background()
+
+	parent, err := ch.SendMessage(ctx, &Message{Text: "parent"}, user.ID)
+	require.NoError(t, err)
+	_, err = ch.SendMessage(ctx, &Message{Text: "reply", ParentID: parent.Message.ID}, user.ID)
+	require.NoError(t, err)
+
+	resp, err := c.PartialUpdateThread(ctx, parent.Message.ID, map[string]interface{}{
+		"title":    "support request",
+		"resolved": true,
+	}, nil, user.ID)
+	require.NoError(t, err)
+	require.Equal(t, "support request", resp.Thread.Title)
+	require.Equal(t, true, resp.Thread.Custom["resolved"])
+
+	resp, err = c.PartialUpdateThread(ctx, parent.Message.ID, nil, []string{"resolved"}, user.ID)
+	require.NoError(t, err)
+	require.NotContains(t, resp.Thread.Custom, "resolved")
+}
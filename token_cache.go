@@ -0,0 +1,98 @@
+package stream_chat
+
+import (
+	"container/list"
+	"sync"
+	"time"
+)
+
+const (
+	// tokenSweepInterval is how often expired tokens are evicted from the cache.
+	tokenSweepInterval = time.Minute
+	// maxCachedTokens is the number of tokens kept in the cache, the least recently used are evicted first.
+	maxCachedTokens = 10000
+)
+
+type tokenCacheKey struct {
+	userID   string
+	expire   int64 // unix seconds, zero if the token never expires
+	issuedAt int64 // unix seconds, zero if not set
+}
+
+func (k tokenCacheKey) expired(now time.Time) bool {
+	return k.expire != 0 && now.Unix() >= k.expire
+}
+
+type tokenCacheEntry struct {
+	key   tokenCacheKey
+	token string
+}
+
+// tokenCache is an in-memory LRU cache of signed user tokens safe for concurrent use.
+type tokenCache struct {
+	mu         sync.Mutex
+	maxEntries int
+	tokens     map[tokenCacheKey]*list.Element
+	lru        *list.List // most recently used at the front
+	lastSweep  time.Time
+}
+
+func newTokenCache(maxEntries int) *tokenCache {
+	return &tokenCache{
+		maxEntries: maxEntries,
+		tokens:     make(map[tokenCacheKey]*list.Element),
+		lru:        list.New(),
+	}
+}
+
+// get returns the cached token for key. Expired tokens are never returned.
+func (tc *tokenCache) get(key tokenCacheKey, now time.Time) (string, bool) {
+	tc.mu.Lock()
+	defer tc.mu.Unlock()
+
+	el, ok := tc.tokens[key]
+	if !ok {
+		return "", false
+	}
+	if key.expired(now) {
+		tc.remove(el)
+		return "", false
+	}
+	tc.lru.MoveToFront(el)
+	return el.Value.(*tokenCacheEntry).token, true
+}
+
+// put stores token for key, evicting expired tokens at most once per tokenSweepInterval
+// and the least recently used tokens when the cache is full.
+func (tc *tokenCache) put(key tokenCacheKey, token string, now time.Time) {
+	tc.mu.Lock()
+	defer tc.mu.Unlock()
+
+	if now.Sub(tc.lastSweep) >= tokenSweepInterval {
+		for k, el := range tc.tokens {
+			if k.expired(now) {
+				tc.remove(el)
+			}
+		}
+		tc.lastSweep = now
+	}
+
+	if key.expired(now) {
+		return
+	}
+	if el, ok := tc.tokens[key]; ok {
+		el.Value.(*tokenCacheEntry).token = token
+		tc.lru.MoveToFront(el)
+		return
+	}
+
+	tc.tokens[key] = tc.lru.PushFront(&tokenCacheEntry{key: key, token: token})
+	for tc.maxEntries > 0 && tc.lru.Len() > tc.maxEntries {
+		tc.remove(tc.lru.Back())
+	}
+}
+
+func (tc *tokenCache) remove(el *list.Element) {
+	tc.lru.Remove(el)
+	delete(tc.tokens, el.Value.(*tokenCacheEntry).key)
+}
@@ -0,0 +1,76 @@
+package stream_chat
+
+import (
+	"context"
+	"errors"
+)
+
+// Tracer starts a span for every API request. The client does not depend on
+// OpenTelemetry; a thin adapter over an OpenTelemetry trace.Tracer satisfies it:
+//
+//	type otelTracer struct{ t trace.Tracer }
+//
+//	func (o otelTracer) Start(ctx context.Context, name string) (context.Context, stream_chat.Span) {
+//		ctx, span := o.t.Start(ctx, name, trace.WithSpanKind(trace.SpanKindClient))
+//		return ctx, otelSpan{span}
+//	}
+type Tracer interface {
+	// Start starts a span with the given name as a child of the span in ctx, if any,
+	// and returns a context containing the new span.
+	Start(ctx context.Context, spanName string) (context.Context, Span)
+}
+
+// Span is a single API request span started by a Tracer.
+type Span interface {
+	SetAttributes(attrs map[string]interface{})
+	// RecordError records err and marks the span as failed.
+	RecordError(err error)
+	End()
+}
+
+// Span attribute keys set on every request span.
+const (
+	SpanAttrHTTPMethod     = "http.method"
+	SpanAttrHTTPStatusCode = "http.status_code"
+	SpanAttrURLPath        = "url.path"
+	SpanAttrErrorCode      = "stream.error_code"
+)
+
+// RequestSpanName is the name of every request span. The endpoint is recorded in
+// the SpanAttrHTTPMethod and SpanAttrURLPath attributes instead, so span names don't
+// contain IDs.
+const RequestSpanName = "stream_chat.request"
+
+// WithTracer creates a span named RequestSpanName for every API request.
+// The span context is propagated to the HTTP request.
+// The streamotel module provides an OpenTelemetry Tracer, see streamotel.WithTracerProvider.
+func WithTracer(tracer Tracer) func(c *Client) {
+	return func(c *Client) {
+		c.tracer = tracer
+	}
+}
+
+func (c *Client) startSpan(ctx context.Context, method, path string) (context.Context, func(statusCode int, err error)) {
+	ctx, span := c.tracer.Start(ctx, RequestSpanName)
+
+	return ctx, func(statusCode int, err error) {
+		attrs := map[string]interface{}{
+			SpanAttrHTTPMethod: method,
+			SpanAttrURLPath:    path,
+		}
+		if statusCode != 0 {
+			attrs[SpanAttrHTTPStatusCode] = statusCode
+		}
+
+		var apiErr Error
+		if errors.As(err, &apiErr) {
+			attrs[SpanAttrErrorCode] = apiErr.Code
+		}
+
+		span.SetAttributes(attrs)
+		if err != nil {
+			span.RecordError(err)
+		}
+		span.End()
+	}
+}
@@ -0,0 +1,66 @@
+package stream_chat
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+type parentSpanKey struct{}
+
+type testSpan struct {
+	name   string
+	parent interface{}
+	attrs  map[string]interface{}
+	err    error
+	ended  bool
+}
+
+func (s *testSpan) SetAttributes(attrs map[string]interface{}) { s.attrs = attrs }
+func (s *testSpan) RecordError(err error)                      { s.err = err }
+func (s *testSpan) End()                                       { s.ended = true }
+
+type testTracer struct {
+	spans []*testSpan
+}
+
+func (t *testTracer) Start(ctx context.Context, spanName string) (context.Context, Span) {
+	span := &testSpan{name: spanName, parent: ctx.Value(parentSpanKey{})}
+	t.spans = append(t.spans, span)
+	return ctx, span
+}
+
+func TestClient_WithTracer(t *testing.T) {
+	c := initClient(t)
+	tracer := &testTracer{}
+	WithTracer(tracer)(c)
+
+	ctx := context.WithValue(context.Background(), parentSpanKey{}, "handler")
+
+	_, err := c.GetAppSettings(ctx)
+	require.NoError(t, err)
+
+	_, err = c.GetMessage(ctx, randomString(12))
+	require.Error(t, err)
+
+	require.Len(t, tracer.spans, 2)
+
+	ok := tracer.spans[0]
+	require.Equal(t, RequestSpanName, ok.name)
+	require.Equal(t, "handler", ok.parent)
+	require.True(t, ok.ended)
+	require.NoError(t, ok.err)
+	require.Equal(t, http.MethodGet, ok.attrs[SpanAttrHTTPMethod])
+	require.Equal(t, "app", ok.attrs[SpanAttrURLPath])
+	require.Equal(t, http.StatusOK, ok.attrs[SpanAttrHTTPStatusCode])
+
+	failed := tracer.spans[1]
+	require.Equal(t, RequestSpanName, failed.name)
+	require.Contains(t, failed.attrs[SpanAttrURLPath], "messages/")
+	require.True(t, failed.ended)
+	require.Error(t, failed.err)
+	require.Equal(t, http.StatusNotFound, failed.attrs[SpanAttrHTTPStatusCode])
+	require.Contains(t, failed.attrs, SpanAttrErrorCode)
+}
@@ -0,0 +1,76 @@
+package stream_chat
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+type UnreadCountsChannel struct {
+	ChannelID   string    `json:"channel_id"`
+	UnreadCount int       `json:"unread_count"`
+	LastRead    time.Time `json:"last_read"`
+}
+
+type UnreadCountsChannelType struct {
+	ChannelType  string `json:"channel_type"`
+	ChannelCount int    `json:"channel_count"`
+	UnreadCount  int    `json:"unread_count"`
+}
+
+type UnreadCountsThread struct {
+	UnreadCount       int       `json:"unread_count"`
+	LastRead          time.Time `json:"last_read"`
+	LastReadMessageID string    `json:"last_read_message_id"`
+	ParentMessageID   string    `json:"parent_message_id"`
+}
+
+type UnreadCounts struct {
+	TotalUnreadCount        int                        `json:"total_unread_count"`
+	TotalUnreadThreadsCount int                        `json:"total_unread_threads_count"`
+	ChannelType             []*UnreadCountsChannelType `json:"channel_type"`
+	Channels                []*UnreadCountsChannel     `json:"channels"`
+	Threads                 []*UnreadCountsThread      `json:"threads"`
+}
+
+type UnreadCountResponse struct {
+	UnreadCounts
+	Response
+}
+
+// GetUnreadCount returns the unread counts of the user with given ID,
+// broken down by channel type, channel and thread.
+func (c *Client) GetUnreadCount(ctx context.Context, userID string) (*UnreadCountResponse, error) {
+	if userID == "" {
+		return nil, errors.New("user ID must be not empty")
+	}
+
+	params := url.Values{}
+	params.Set("user_id", userID)
+
+	var resp UnreadCountResponse
+	err := c.makeRequest(ctx, http.MethodGet, "unread", params, nil, &resp)
+	return &resp, err
+}
+
+type UnreadCountBatchResponse struct {
+	CountsByUser map[string]*UnreadCounts `json:"counts_by_user"`
+	Response
+}
+
+// GetUnreadCountBatch returns the unread counts for multiple users at once, keyed by user ID.
+func (c *Client) GetUnreadCountBatch(ctx context.Context, userIDs []string) (*UnreadCountBatchResponse, error) {
+	if len(userIDs) == 0 {
+		return nil, errors.New("user IDs must be not empty")
+	}
+
+	data := map[string]interface{}{
+		"user_ids": userIDs,
+	}
+
+	var resp UnreadCountBatchResponse
+	err := c.makeRequest(ctx, http.MethodPost, "unread_batch", nil, data, &resp)
+	return &resp, err
+}
@@ -0,0 +1,63 @@
+package stream_chat
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_GetUnreadCount(t *testing.T) {
+	c := initClient(t)
+	user := randomUser(t, c)
+	ch := initChannel(t, c, user.ID)
+	ctx := context.Background()
+
+	_, err := ch.SendMessage(ctx, &Message{Text: "test message"}, ch.CreatedBy.ID, MessageSkipPush)
+	require.NoError(t, err, "send message")
+
+	resp, err := c.GetUnreadCount(ctx, user.ID)
+	require.NoError(t, err)
+	require.Equal(t, 1, resp.TotalUnreadCount)
+	require.Len(t, resp.Channels, 1)
+	require.Equal(t, ch.CID, resp.Channels[0].ChannelID)
+	require.Equal(t, 1, resp.Channels[0].UnreadCount)
+}
+
+func TestClient_GetUnreadCountBatch(t *testing.T) {
+	c := initClient(t)
+	user1 := randomUser(t, c)
+	user2 := randomUser(t, c)
+	ch := initChannel(t, c, user1.ID, user2.ID)
+	ctx := context.Background()
+
+	_, err := ch.SendMessage(ctx, &Message{Text: "test message"}, ch.CreatedBy.ID, MessageSkipPush)
+	require.NoError(t, err, "send message")
+
+	_, err = c.GetUnreadCountBatch(ctx, nil)
+	require.Error(t, err)
+
+	resp, err := c.GetUnreadCountBatch(ctx, []string{user1.ID, user2.ID})
+	require.NoError(t, err)
+	require.Len(t, resp.CountsByUser, 2)
+	require.Equal(t, 1, resp.CountsByUser[user1.ID].TotalUnreadCount)
+	require.Equal(t, 1, resp.CountsByUser[user2.ID].TotalUnreadCount)
+}
+
+func TestClient_MarkAllReadWithCounts(t *testing.T) {
+	c := initClient(t)
+	user := randomUser(t, c)
+	ch1 := initChannel(t, c, user.ID)
+	ch2 := initChannel(t, c, user.ID)
+	ctx := context.Background()
+
+	for _, ch := range []*Channel{ch1, ch2} {
+		_, err := ch.SendMessage(ctx, &Message{Text: "test message"}, ch.CreatedBy.ID, MessageSkipPush)
+		require.NoError(t, err, "send message")
+	}
+
+	resp, err := c.MarkAllReadWithCounts(ctx, user.ID)
+	require.NoError(t, err)
+	require.Equal(t, 2, resp.ChannelsMarked)
+	require.Zero(t, resp.TotalUnreadCount)
+}
@@ -15,6 +15,7 @@ import (
 type Mute struct {
 	User      User       `json:"user"`
 	Target    User       `json:"target"`
+	Channel   *Channel   `json:"channel,omitempty"` // set only for mutes returned by QueryMutes for muted channels
 	CreatedAt time.Time  `json:"created_at"`
 	UpdatedAt time.Time  `json:"updated_at"`
 	Expires   *time.Time `json:"expires"`
@@ -40,9 +41,11 @@ type User struct {
 	Online    bool `json:"online,omitempty"`
 	Invisible bool `json:"invisible,omitempty"`
 
-	CreatedAt  *time.Time `json:"created_at,omitempty"`
-	UpdatedAt  *time.Time `json:"updated_at,omitempty"`
-	LastActive *time.Time `json:"last_active,omitempty"`
+	CreatedAt     *time.Time `json:"created_at,omitempty"`
+	UpdatedAt     *time.Time `json:"updated_at,omitempty"`
+	LastActive    *time.Time `json:"last_active,omitempty"`
+	DeletedAt     *time.Time `json:"deleted_at,omitempty"`
+	DeactivatedAt *time.Time `json:"deactivated_at,omitempty"`
 
 	Mutes                    []*Mute                `json:"mutes,omitempty"`
 	ChannelMutes             []*ChannelMute         `json:"channel_mutes,omitempty"`
@@ -112,6 +115,35 @@ func (c *Client) MuteUser(ctx context.Context, targetID, mutedBy string, options
 	return &resp, err
 }
 
+type MuteResponse struct {
+	Mute    *Mute `json:"mute"`
+	OwnUser *User `json:"own_user"`
+	Response
+}
+
+// MuteUserWithExpiration mutes targetID for the given timeout and returns the created mute.
+// The timeout is sent in minutes, so it must be at least a minute.
+func (c *Client) MuteUserWithExpiration(ctx context.Context, targetID, userID string, timeout time.Duration) (*MuteResponse, error) {
+	switch {
+	case targetID == "":
+		return nil, errors.New("targetID should not be empty")
+	case userID == "":
+		return nil, errors.New("userID should not be empty")
+	case timeout < time.Minute:
+		return nil, errors.New("timeout should be at least a minute")
+	}
+
+	opts := &muteOptions{
+		TargetID:   targetID,
+		UserID:     userID,
+		Expiration: int(timeout.Minutes()),
+	}
+
+	var resp MuteResponse
+	err := c.makeRequest(ctx, http.MethodPost, "moderation/mute", nil, opts, &resp)
+	return &resp, err
+}
+
 // MuteUsers mutes all users in targetIDs.
 func (c *Client) MuteUsers(ctx context.Context, targetIDs []string, mutedBy string, options ...MuteOption) (*Response, error) {
 	switch {
@@ -135,6 +167,129 @@ func (c *Client) MuteUsers(ctx context.Context, targetIDs []string, mutedBy stri
 	return &resp, err
 }
 
+// MuteUsersWithTimeout mutes all users in targetIDs for the given timeout, e.g. to
+// temporarily silence a spam wave. Duplicate target IDs are muted once.
+// The timeout is sent in minutes, so it must be at least a minute.
+func (c *Client) MuteUsersWithTimeout(ctx context.Context, targetIDs []string, userID string, timeout time.Duration) (*Response, error) {
+	if timeout < time.Minute {
+		return nil, errors.New("timeout should be at least a minute")
+	}
+
+	seen := make(map[string]bool, len(targetIDs))
+	unique := make([]string, 0, len(targetIDs))
+	for _, id := range targetIDs {
+		if id == "" {
+			return nil, errors.New("targetIDs should not contain empty IDs")
+		}
+		if !seen[id] {
+			seen[id] = true
+			unique = append(unique, id)
+		}
+	}
+
+	return c.MuteUsers(ctx, unique, userID, MuteWithExpiration(int(timeout.Minutes())))
+}
+
+// QueryMutes returns the users and channels muted by the user with given ID.
+// Channel mutes have Channel set and an empty Target.
+func (c *Client) QueryMutes(ctx context.Context, userID string) ([]*Mute, error) {
+	if userID == "" {
+		return nil, errors.New("user ID must be not empty")
+	}
+
+	resp, err := c.QueryUsers(ctx, &QueryOption{
+		Filter: map[string]interface{}{
+			"id": map[string]string{"$eq": userID},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(resp.Users) == 0 {
+		return nil, fmt.Errorf("user %q not found", userID)
+	}
+
+	user := resp.Users[0]
+	mutes := make([]*Mute, 0, len(user.Mutes)+len(user.ChannelMutes))
+	mutes = append(mutes, user.Mutes...)
+	for _, cm := range user.ChannelMutes {
+		ch := cm.Channel
+		mutes = append(mutes, &Mute{
+			User:      cm.User,
+			Channel:   &ch,
+			Expires:   cm.Expires,
+			CreatedAt: cm.CreatedAt,
+			UpdatedAt: cm.UpdatedAt,
+		})
+	}
+	return mutes, nil
+}
+
+// BlockedUser represents a user blocked by another user.
+type BlockedUser struct {
+	UserID        string    `json:"user_id"`
+	User          *User     `json:"user,omitempty"`
+	BlockedUserID string    `json:"blocked_user_id"`
+	BlockedUser   *User     `json:"blocked_user,omitempty"`
+	CreatedAt     time.Time `json:"created_at"`
+}
+
+// BlockUser blocks blockedUserID on behalf of userID.
+// Unlike muting, blocking prevents the blocked user from sending direct messages to the user.
+func (c *Client) BlockUser(ctx context.Context, blockedUserID, userID string) (*Response, error) {
+	switch {
+	case blockedUserID == "":
+		return nil, errors.New("blockedUserID should not be empty")
+	case userID == "":
+		return nil, errors.New("userID should not be empty")
+	}
+
+	data := map[string]string{
+		"blocked_user_id": blockedUserID,
+		"user_id":         userID,
+	}
+
+	var resp Response
+	err := c.makeRequest(ctx, http.MethodPost, "users/block", nil, data, &resp)
+	return &resp, err
+}
+
+// UnblockUser unblocks blockedUserID on behalf of userID.
+func (c *Client) UnblockUser(ctx context.Context, blockedUserID, userID string) (*Response, error) {
+	switch {
+	case blockedUserID == "":
+		return nil, errors.New("blockedUserID should not be empty")
+	case userID == "":
+		return nil, errors.New("userID should not be empty")
+	}
+
+	data := map[string]string{
+		"blocked_user_id": blockedUserID,
+		"user_id":         userID,
+	}
+
+	var resp Response
+	err := c.makeRequest(ctx, http.MethodPost, "users/unblock", nil, data, &resp)
+	return &resp, err
+}
+
+// GetBlockedUsers returns the users blocked by userID.
+func (c *Client) GetBlockedUsers(ctx context.Context, userID string) ([]*BlockedUser, error) {
+	if userID == "" {
+		return nil, errors.New("userID should not be empty")
+	}
+
+	params := url.Values{}
+	params.Set("user_id", userID)
+
+	var resp struct {
+		Blocks []*BlockedUser `json:"blocks"`
+		Response
+	}
+	err := c.makeRequest(ctx, http.MethodGet, "users/block", params, nil, &resp)
+	return resp.Blocks, err
+}
+
 // UnmuteUser unmute targetID.
 func (c *Client) UnmuteUser(ctx context.Context, targetID, unmutedBy string) (*Response, error) {
 	switch {
@@ -192,6 +347,23 @@ func (c *Client) FlagUser(ctx context.Context, targetID, flaggedBy string) (*Res
 	return &resp, err
 }
 
+// FlagUserWithOptions flags the user with the given targetID with a reason, e.g. "harassment",
+// and custom data, and returns the created flag. reason and custom are optional.
+func (c *Client) FlagUserWithOptions(ctx context.Context, targetID, flaggedBy, reason string, custom map[string]interface{}) (*FlagResponse, error) {
+	switch {
+	case targetID == "":
+		return nil, errors.New("targetID should not be empty")
+	case flaggedBy == "":
+		return nil, errors.New("flaggedBy should not be empty")
+	}
+
+	data := map[string]interface{}{
+		"target_user_id": targetID,
+		"user_id":        flaggedBy,
+	}
+	return c.flag(ctx, data, reason, custom)
+}
+
 type ReviewFlagReportRequest struct {
 	ReviewResult  string                 `json:"review_result,omitempty"`
 	UserID        string                 `json:"user_id,omitempty"`
@@ -218,6 +390,41 @@ func (c *Client) ReviewFlagReport(ctx context.Context, reportID string, req *Rev
 	return resp, err
 }
 
+// ReviewAction is the outcome of a flag review.
+type ReviewAction string
+
+const (
+	// ReviewActionKeep keeps the flagged content and dismisses the flag.
+	ReviewActionKeep ReviewAction = "keep"
+	// ReviewActionDelete deletes the flagged content.
+	ReviewActionDelete ReviewAction = "delete"
+	// ReviewActionMarkReviewed marks the flag as reviewed without taking further action.
+	ReviewActionMarkReviewed ReviewAction = "mark_reviewed"
+)
+
+// ReviewFlag resolves the flag (report) with the given flagID with the given action,
+// reviewed by the user with the given userID.
+func (c *Client) ReviewFlag(ctx context.Context, flagID string, action ReviewAction, userID string) (*Response, error) {
+	switch {
+	case flagID == "":
+		return nil, errors.New("flag ID is empty")
+	case action == "":
+		return nil, errors.New("review action is empty")
+	case userID == "":
+		return nil, errors.New("user ID is empty")
+	}
+
+	p := path.Join("moderation", "reports", url.PathEscape(flagID))
+	req := &ReviewFlagReportRequest{
+		ReviewResult: string(action),
+		UserID:       userID,
+	}
+
+	var resp Response
+	err := c.makeRequest(ctx, http.MethodPatch, p, nil, req, &resp)
+	return &resp, err
+}
+
 type GuestUserResponse struct {
 	User        *User  `json:"user"`
 	AccessToken string `json:"access_token"`
@@ -232,11 +439,14 @@ func (c *Client) CreateGuestUser(ctx context.Context, user *User) (*GuestUserRes
 }
 
 type ExportUserResponse struct {
-	*User
+	User      *User       `json:"user"`
+	Messages  []*Message  `json:"messages,omitempty"`
+	Reactions []*Reaction `json:"reactions,omitempty"`
 	Response
 }
 
-// ExportUser exports the user with the given target user ID.
+// ExportUser exports the user with the given target user ID, together with its messages and reactions.
+// Use ExportUserFull to include other related data.
 func (c *Client) ExportUser(ctx context.Context, targetID string) (*ExportUserResponse, error) {
 	if targetID == "" {
 		return nil, errors.New("target ID is empty")
@@ -249,6 +459,78 @@ func (c *Client) ExportUser(ctx context.Context, targetID string) (*ExportUserRe
 	return &resp, err
 }
 
+// ExportUserOptions selects the related data ExportUserFull includes in the export.
+type ExportUserOptions struct {
+	WithMutes    bool // the users and channels muted by the user
+	WithChannels bool // the channels the user is a member of, without their messages and members
+	WithDevices  bool // the devices of the user
+	WithBans     bool // the active bans of the user, both global and channel bans
+}
+
+// UserExport is a snapshot of a user and its related data.
+type UserExport struct {
+	User     *User
+	Mutes    []*Mute
+	Channels []*Channel
+	Devices  []*Device
+	Bans     []*Ban
+}
+
+// ExportUserFull exports the user with the given target user ID together with
+// the related data selected by opts. Each section is fetched with a separate API call.
+func (c *Client) ExportUserFull(ctx context.Context, targetID string, opts *ExportUserOptions) (*UserExport, error) {
+	if opts == nil {
+		opts = &ExportUserOptions{}
+	}
+
+	resp, err := c.ExportUser(ctx, targetID)
+	if err != nil {
+		return nil, err
+	}
+	export := &UserExport{User: resp.User}
+
+	if opts.WithMutes {
+		if export.Mutes, err = c.QueryMutes(ctx, targetID); err != nil {
+			return nil, fmt.Errorf("cannot export mutes: %w", err)
+		}
+	}
+
+	if opts.WithChannels {
+		zero := 0
+		it := c.QueryChannelsAll(ctx, &QueryOption{
+			Filter:       Filter{}.In("members", []string{targetID}),
+			MessageLimit: &zero,
+			MemberLimit:  &zero,
+		})
+		for it.Next() {
+			export.Channels = append(export.Channels, it.Channel())
+		}
+		if err := it.Err(); err != nil {
+			return nil, fmt.Errorf("cannot export channels: %w", err)
+		}
+	}
+
+	if opts.WithDevices {
+		devices, err := c.GetDevices(ctx, targetID)
+		if err != nil {
+			return nil, fmt.Errorf("cannot export devices: %w", err)
+		}
+		export.Devices = devices.Devices
+	}
+
+	if opts.WithBans {
+		bans, err := c.QueryBannedUsers(ctx, &QueryBannedUsersOptions{
+			QueryOption: &QueryOption{Filter: Filter{}.Eq("user_id", targetID)},
+		})
+		if err != nil {
+			return nil, fmt.Errorf("cannot export bans: %w", err)
+		}
+		export.Bans = bans.Bans
+	}
+
+	return export, nil
+}
+
 type deactivateUserOptions struct {
 	MarkMessagesDeleted bool   `json:"mark_messages_deleted"`
 	CreatedByID         string `json:"created_by_id"`
@@ -256,12 +538,14 @@ type deactivateUserOptions struct {
 
 type DeactivateUserOptions func(*deactivateUserOptions)
 
+// DeactivateUserWithMarkMessagesDeleted marks the messages of the user as deleted.
 func DeactivateUserWithMarkMessagesDeleted() func(*deactivateUserOptions) {
 	return func(opt *deactivateUserOptions) {
 		opt.MarkMessagesDeleted = true
 	}
 }
 
+// DeactivateUserWithCreatedBy attributes the deactivation to the user with the given ID.
 func DeactivateUserWithCreatedBy(userID string) func(*deactivateUserOptions) {
 	return func(opt *deactivateUserOptions) {
 		opt.CreatedByID = userID
@@ -286,6 +570,37 @@ func (c *Client) DeactivateUser(ctx context.Context, targetID string, options ..
 	return &resp, err
 }
 
+// DeactivateUserXOptions configures DeactivateUserX.
+type DeactivateUserXOptions struct {
+	// MarkMessagesDeleted marks the messages of the user as deleted.
+	MarkMessagesDeleted bool
+	// CreatedByID attributes the deactivation to the user with the given ID, e.g. an admin.
+	CreatedByID string
+}
+
+type DeactivateUserResponse struct {
+	User *User `json:"user"`
+	Response
+}
+
+// DeactivateUserX deactivates the user with the given target user ID and returns the updated user.
+func (c *Client) DeactivateUserX(ctx context.Context, targetID string, opts DeactivateUserXOptions) (*DeactivateUserResponse, error) {
+	if targetID == "" {
+		return nil, errors.New("target ID is empty")
+	}
+
+	req := deactivateUserOptions{
+		MarkMessagesDeleted: opts.MarkMessagesDeleted,
+		CreatedByID:         opts.CreatedByID,
+	}
+
+	p := path.Join("users", url.PathEscape(targetID), "deactivate")
+
+	var resp DeactivateUserResponse
+	err := c.makeRequest(ctx, http.MethodPost, p, nil, req, &resp)
+	return &resp, err
+}
+
 type reactivateUserOptions struct {
 	RestoreMessages bool   `json:"restore_messages"`
 	Name            string `json:"name"`
@@ -381,6 +696,19 @@ func (c *Client) DeleteUser(ctx context.Context, targetID string, options ...Del
 	return &resp, err
 }
 
+// RestoreUsers restores soft deleted users with the given IDs, including their data.
+func (c *Client) RestoreUsers(ctx context.Context, userIDs []string) (*Response, error) {
+	if len(userIDs) == 0 {
+		return nil, errors.New("user IDs are empty")
+	}
+
+	data := map[string]interface{}{"user_ids": userIDs}
+
+	var resp Response
+	err := c.makeRequest(ctx, http.MethodPost, "users/restore", nil, data, &resp)
+	return &resp, err
+}
+
 type usersRequest struct {
 	Users map[string]userRequest `json:"users"`
 }
@@ -413,13 +741,45 @@ type UsersResponse struct {
 	Response
 }
 
+// maxUpsertUsers is the maximum number of users the API accepts in a single upsert request.
+const maxUpsertUsers = 100
+
 // UpsertUsers creates the given users. If a user doesn't exist, it will be created.
 // Otherwise, custom data will be extended or updated. Missing data is never removed.
+// Users are sent in sequential batches of 100. If a batch fails, the remaining
+// batches are still sent and the first error is returned along with the users
+// upserted by the successful batches.
 func (c *Client) UpsertUsers(ctx context.Context, users ...*User) (*UsersResponse, error) {
 	if len(users) == 0 {
 		return nil, errors.New("users are not set")
 	}
 
+	resp := UsersResponse{Users: make(map[string]*User, len(users))}
+	var firstErr error
+	for start := 0; start < len(users); start += maxUpsertUsers {
+		end := start + maxUpsertUsers
+		if end > len(users) {
+			end = len(users)
+		}
+
+		batch, err := c.upsertUsers(ctx, users[start:end])
+		if err != nil {
+			if firstErr == nil {
+				firstErr = err
+			}
+			continue
+		}
+
+		for id, u := range batch.Users {
+			resp.Users[id] = u
+		}
+		resp.Response = batch.Response
+	}
+
+	return &resp, firstErr
+}
+
+func (c *Client) upsertUsers(ctx context.Context, users []*User) (*UsersResponse, error) {
 	req := usersRequest{Users: make(map[string]userRequest, len(users))}
 	for _, u := range users {
 		req.Users[u.ID] = userRequest{User: u}
@@ -4,22 +4,127 @@ import (
 	"context"
 	"log"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
 
 func TestClient_DeactivateUser(t *testing.T) {
+	c := initClient(t)
+	ctx := context.Background()
+	user := randomUser(t, c)
+	admin := randomUser(t, c)
+
+	_, err := c.DeactivateUserX(ctx, "", DeactivateUserXOptions{})
+	require.Error(t, err)
+
+	resp, err := c.DeactivateUserX(ctx, user.ID, DeactivateUserXOptions{CreatedByID: admin.ID, MarkMessagesDeleted: true})
+	require.NoError(t, err)
+	require.Equal(t, user.ID, resp.User.ID)
+	require.NotNil(t, resp.User.DeactivatedAt)
 }
 
 func TestClient_DeleteUser(t *testing.T) {
 }
 
-func TestClient_ExportUser(t *testing.T) {}
+func TestClient_RestoreUsers(t *testing.T) {
+	c := initClient(t)
+	ctx := context.Background()
+	user := randomUser(t, c)
+
+	_, err := c.DeleteUser(ctx, user.ID)
+	require.NoError(t, err)
+
+	_, err = c.RestoreUsers(ctx, nil)
+	require.Error(t, err)
+
+	_, err = c.RestoreUsers(ctx, []string{user.ID})
+	require.NoError(t, err)
+
+	resp, err := c.QueryUsers(ctx, &QueryOption{
+		Filter: map[string]interface{}{"id": map[string]string{"$eq": user.ID}},
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.Users, 1)
+	require.Nil(t, resp.Users[0].DeletedAt)
+}
+
+func TestClient_ExportUser(t *testing.T) {
+	c := initClient(t)
+	ctx := context.Background()
+	user := randomUser(t, c)
+	ch := initChannel(t, c, user.ID)
+
+	_, err := c.ExportUser(ctx, "")
+	require.Error(t, err)
+
+	msg, err := ch.SendMessage(ctx, &Message{Text: "test message"}, user.ID)
+	require.NoError(t, err)
+	_, err = c.SendReaction(ctx, &Reaction{Type: "love"}, msg.Message.ID, user.ID)
+	require.NoError(t, err)
+
+	resp, err := c.ExportUser(ctx, user.ID)
+	require.NoError(t, err)
+	require.NotNil(t, resp.User)
+	require.Equal(t, user.ID, resp.User.ID)
+	require.Len(t, resp.Messages, 1)
+	require.Equal(t, msg.Message.ID, resp.Messages[0].ID)
+	require.Len(t, resp.Reactions, 1)
+	require.Equal(t, "love", resp.Reactions[0].Type)
+}
+
+func TestClient_ExportUserFull(t *testing.T) {
+	c := initClient(t)
+	ctx := context.Background()
+	user := randomUser(t, c)
+	target := randomUser(t, c)
+	ch := initChannel(t, c, user.ID)
+
+	_, err := c.MuteUser(ctx, target.ID, user.ID)
+	require.NoError(t, err)
+	_, err = c.AddDevice(ctx, &Device{ID: randomString(12), UserID: user.ID, PushProvider: PushProviderFirebase})
+	require.NoError(t, err)
+	_, err = ch.BanUser(ctx, user.ID, target.ID)
+	require.NoError(t, err)
+
+	export, err := c.ExportUserFull(ctx, user.ID, nil)
+	require.NoError(t, err)
+	require.Equal(t, user.ID, export.User.ID)
+	require.Empty(t, export.Mutes)
+	require.Empty(t, export.Channels)
+
+	export, err = c.ExportUserFull(ctx, user.ID, &ExportUserOptions{
+		WithMutes:    true,
+		WithChannels: true,
+		WithDevices:  true,
+		WithBans:     true,
+	})
+	require.NoError(t, err)
+	require.Len(t, export.Mutes, 1)
+	require.Equal(t, target.ID, export.Mutes[0].Target.ID)
+	require.Len(t, export.Channels, 1)
+	require.Equal(t, ch.CID, export.Channels[0].CID)
+	require.Len(t, export.Devices, 1)
+	require.Len(t, export.Bans, 1)
+}
 
 func TestClient_FlagUser(t *testing.T) {
 }
 
+func TestClient_FlagUserWithOptions(t *testing.T) {
+	c := initClient(t)
+	ctx := context.Background()
+
+	target := randomUser(t, c)
+	flagger := randomUser(t, c)
+
+	resp, err := c.FlagUserWithOptions(ctx, target.ID, flagger.ID, "harassment", nil)
+	require.NoError(t, err)
+	require.Equal(t, target.ID, resp.Flag.TargetUser.ID)
+	require.Equal(t, flagger.ID, resp.Flag.User.ID)
+}
+
 func TestClient_MuteUser(t *testing.T) {
 	c := initClient(t)
 	ctx := context.Background()
@@ -66,6 +171,24 @@ func TestClient_MuteUser(t *testing.T) {
 	assert.NotEmpty(t, mute.Expires, "mute should have Expires")
 }
 
+func TestClient_MuteUserWithExpiration(t *testing.T) {
+	c := initClient(t)
+	ctx := context.Background()
+
+	user := randomUser(t, c)
+	target := randomUser(t, c)
+
+	_, err := c.MuteUserWithExpiration(ctx, target.ID, user.ID, time.Second)
+	require.Error(t, err)
+
+	resp, err := c.MuteUserWithExpiration(ctx, target.ID, user.ID, time.Hour)
+	require.NoError(t, err)
+	require.Equal(t, user.ID, resp.Mute.User.ID)
+	require.Equal(t, target.ID, resp.Mute.Target.ID)
+	require.NotNil(t, resp.Mute.Expires)
+	require.WithinDuration(t, time.Now().Add(time.Hour), *resp.Mute.Expires, time.Minute)
+}
+
 func TestClient_MuteUsers(t *testing.T) {
 	c := initClient(t)
 	ctx := context.Background()
@@ -92,6 +215,88 @@ func TestClient_MuteUsers(t *testing.T) {
 	}
 }
 
+func TestClient_MuteUsersWithTimeout(t *testing.T) {
+	c := initClient(t)
+	ctx := context.Background()
+
+	user := randomUser(t, c)
+	targetIDs := randomUsersID(t, c, 2)
+
+	_, err := c.MuteUsersWithTimeout(ctx, targetIDs, user.ID, time.Second)
+	require.Error(t, err)
+	_, err = c.MuteUsersWithTimeout(ctx, []string{targetIDs[0], ""}, user.ID, time.Hour)
+	require.Error(t, err)
+
+	_, err = c.MuteUsersWithTimeout(ctx, append(targetIDs, targetIDs[0]), user.ID, time.Hour)
+	require.NoError(t, err)
+
+	mutes, err := c.QueryMutes(ctx, user.ID)
+	require.NoError(t, err)
+	require.Len(t, mutes, 2)
+	for _, mute := range mutes {
+		require.NotNil(t, mute.Expires)
+		require.WithinDuration(t, time.Now().Add(time.Hour), *mute.Expires, time.Minute)
+	}
+}
+
+func TestClient_QueryMutes(t *testing.T) {
+	c := initClient(t)
+	ctx := context.Background()
+
+	user := randomUser(t, c)
+	target := randomUser(t, c)
+	ch := initChannel(t, c, user.ID)
+
+	_, err := c.MuteUser(ctx, target.ID, user.ID, MuteWithExpiration(60))
+	require.NoError(t, err)
+	_, err = ch.Mute(ctx, user.ID, nil)
+	require.NoError(t, err)
+
+	mutes, err := c.QueryMutes(ctx, user.ID)
+	require.NoError(t, err)
+	require.Len(t, mutes, 2)
+
+	var userMute, channelMute *Mute
+	for _, m := range mutes {
+		if m.Channel != nil {
+			channelMute = m
+		} else {
+			userMute = m
+		}
+	}
+	require.NotNil(t, userMute)
+	require.Equal(t, target.ID, userMute.Target.ID)
+	require.NotNil(t, userMute.Expires)
+	require.NotNil(t, channelMute)
+	require.Equal(t, ch.CID, channelMute.Channel.CID)
+	require.Equal(t, user.ID, channelMute.User.ID)
+}
+
+func TestClient_BlockUser(t *testing.T) {
+	c := initClient(t)
+	ctx := context.Background()
+
+	user := randomUser(t, c)
+	blocked := randomUser(t, c)
+
+	_, err := c.BlockUser(ctx, blocked.ID, user.ID)
+	require.NoError(t, err)
+
+	blocks, err := c.GetBlockedUsers(ctx, user.ID)
+	require.NoError(t, err)
+	require.Len(t, blocks, 1)
+	require.Equal(t, user.ID, blocks[0].UserID)
+	require.Equal(t, blocked.ID, blocks[0].BlockedUserID)
+	require.NotZero(t, blocks[0].CreatedAt)
+
+	_, err = c.UnblockUser(ctx, blocked.ID, user.ID)
+	require.NoError(t, err)
+
+	blocks, err = c.GetBlockedUsers(ctx, user.ID)
+	require.NoError(t, err)
+	require.Empty(t, blocks)
+}
+
 func TestClient_UnmuteUser(t *testing.T) {
 	c := initClient(t)
 	ctx := context.Background()
@@ -146,6 +351,40 @@ func TestClient_UpsertUsers(t *testing.T) {
 	assert.NotEmpty(t, resp.Users[user.ID].UpdatedAt)
 }
 
+func TestClient_UpsertUsers_Batches(t *testing.T) {
+	c := initClient(t)
+	ctx := context.Background()
+
+	var requests int
+	WithRequestHook(func(_ context.Context, info RequestInfo) {
+		if info.Path == "users" {
+			requests++
+		}
+	})(c)
+
+	users := make([]*User, 0, 250)
+	userIDs := make([]string, 0, 250)
+	for i := 0; i < 250; i++ {
+		u := &User{ID: randomString(10)}
+		users = append(users, u)
+		userIDs = append(userIDs, u.ID)
+	}
+	t.Cleanup(func() {
+		for start := 0; start < len(userIDs); start += 100 {
+			end := start + 100
+			if end > len(userIDs) {
+				end = len(userIDs)
+			}
+			_, _ = c.DeleteUsers(ctx, userIDs[start:end], DeleteUserOptions{User: HardDelete})
+		}
+	})
+
+	resp, err := c.UpsertUsers(ctx, users...)
+	require.NoError(t, err)
+	require.Equal(t, 3, requests)
+	require.Len(t, resp.Users, 250)
+}
+
 func TestClient_PartialUpdateUsers(t *testing.T) {
 	c := initClient(t)
 	ctx := context.Background()